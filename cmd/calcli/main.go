@@ -1,36 +1,143 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/NaMinhyeok/calcli/internal/app"
 	"github.com/NaMinhyeok/calcli/internal/config"
 	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/ical"
+	"github.com/NaMinhyeok/calcli/internal/render"
 	"github.com/NaMinhyeok/calcli/internal/storage/cache"
 	"github.com/NaMinhyeok/calcli/internal/storage/vdir"
 	"github.com/NaMinhyeok/calcli/internal/util"
+
+	"github.com/charmbracelet/x/term"
 )
 
 // Global cache instance (shared across all commands in the session)
 var globalCache *cache.EventCache
 
-func loadConfigAndCalendar() (*config.Config, domain.Calendar) {
+// Time zone used to interpret date arguments and display events
+var displayLocation = time.Local
+
+// First day of the week used by week keywords such as "this-week"
+var weekStart = time.Sunday
+
+// Calendars selected with --calendar, the default calendar when empty
+var calendarNames stringList
+
+// stringList is a repeatable string flag
+type stringList []string
+
+func (s *stringList) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringList) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+// calendarFlag registers the repeatable --calendar flag on fs
+func calendarFlag(fs *flag.FlagSet) {
+	fs.Var(&calendarNames, "calendar", "Calendar to use (repeatable, 'all' for every calendar)")
+}
+
+func loadConfig() *config.Config {
 	cfg, err := config.Load(config.GetDefaultConfigPath())
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Config error: %v\n", err)
-		os.Exit(1)
+		exitf(1, "Config error: %v\n", err)
 	}
+	return cfg
+}
 
-	calendar, err := cfg.GetDefaultCalendar()
+// calendarOptions returns the week layout of the configuration with the
+// colors of calendars, sized to the terminal. Exits on an unknown locale.
+func calendarOptions(cfg *config.Config, calendars []domain.Calendar) app.CalendarOptions {
+	locale, err := render.LookupLocale(cfg.Defaults.Locale)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Calendar error: %v\n", err)
-		os.Exit(1)
+		exitf(1, "Config error: %v\n", err)
+	}
+	return app.CalendarOptions{
+		WeekStart:       weekStart,
+		Locale:          locale,
+		ShowWeekNumbers: cfg.Defaults.ShowWeekNumbers,
+		Colors:          calendarColors(calendars),
+		Width:           terminalWidth(),
 	}
+}
 
-	return cfg, calendar
+// selectedCalendars resolves --calendar. Exits on unknown calendars.
+func selectedCalendars(cfg *config.Config) []domain.Calendar {
+	calendars, err := cfg.SelectCalendars(calendarNames)
+	if err != nil {
+		exitf(1, "Calendar error: %v\n", err)
+	}
+	return calendars
+}
+
+// targetCalendar resolves --calendar to the single calendar written to
+func targetCalendar(cfg *config.Config) domain.Calendar {
+	calendars := selectedCalendars(cfg)
+	if len(calendars) != 1 {
+		exitf(2, "Select a single calendar to write to with --calendar\n")
+	}
+	return calendars[0]
+}
+
+// writableCalendar resolves --calendar like targetCalendar and exits when
+// the calendar is read-only
+func writableCalendar(cfg *config.Config) domain.Calendar {
+	calendar := targetCalendar(cfg)
+	if calendar.ReadOnly {
+		exitf(1, "Calendar '%s' is read-only. Choose another calendar with --calendar\n", calendar.Name)
+	}
+	return calendar
+}
+
+// readOnlyCalendars returns the names of the read-only calendars
+func readOnlyCalendars(calendars []domain.Calendar) []string {
+	var names []string
+	for _, calendar := range calendars {
+		if calendar.ReadOnly {
+			names = append(names, calendar.Name)
+		}
+	}
+	return names
+}
+
+// parseArgs parses flags given before or after positional arguments and
+// returns the positional arguments
+func parseArgs(fs *flag.FlagSet, args []string) []string {
+	var positional []string
+	for {
+		fs.Parse(args)
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// terminalWidth returns the width of the terminal on stdout, 80 when stdout
+// is not a terminal
+func terminalWidth() int {
+	width, _, err := term.GetSize(os.Stdout.Fd())
+	if err != nil || width <= 0 {
+		return 80
+	}
+	return width
 }
 
 // exitf prints to stderr and exits with the given code.
@@ -44,7 +151,7 @@ func mustParseDatePtr(v string, name string) *time.Time {
 	if v == "" {
 		return nil
 	}
-	t, err := util.ParseDate(v)
+	t, err := util.ParseDateInWeek(v, displayLocation, weekStart)
 	if err != nil {
 		exitf(2, "Invalid %s date: %v\n", name, err)
 	}
@@ -53,19 +160,108 @@ func mustParseDatePtr(v string, name string) *time.Time {
 
 // helpers to construct storage/format components
 func readerFor(calendar domain.Calendar) *vdir.Reader {
-	reader := vdir.NewReader(os.DirFS(calendar.Path), ".")
+	reader := vdir.NewReader(os.DirFS(calendar.Path), ".").WithName(calendar.Name)
 	if globalCache != nil {
 		reader = reader.WithCache(globalCache)
 	}
 	return reader
 }
 
+// readerForAll merges the events of the given calendars
+func readerForAll(calendars []domain.Calendar) *vdir.MultiReader {
+	readers := make([]*vdir.Reader, len(calendars))
+	for i, calendar := range calendars {
+		readers[i] = readerFor(calendar)
+	}
+	return vdir.NewMultiReader(readers...)
+}
+
 func writerFor(calendar domain.Calendar) *vdir.Writer {
-	return vdir.NewWriter(calendar.Path)
+	writer := vdir.NewWriter(calendar.Path).WithName(calendar.Name).WithReadOnly(calendar.ReadOnly)
+	if globalCache != nil {
+		writer = writer.WithCache(globalCache)
+	}
+	return writer
+}
+
+// writersFor routes changes of existing events to the calendar holding them,
+// searching every calendar unless --calendar is given
+func writersFor(cfg *config.Config) *vdir.WriterSet {
+	calendars := allCalendarsUnlessSelected(cfg)
+	writers := make([]*vdir.Writer, len(calendars))
+	for i, calendar := range calendars {
+		writers[i] = writerFor(calendar)
+	}
+	return vdir.NewWriterSet(writers...)
+}
+
+// allCalendarsUnlessSelected resolves --calendar, selecting every calendar
+// when it is not given
+func allCalendarsUnlessSelected(cfg *config.Config) []domain.Calendar {
+	names := []string(calendarNames)
+	if len(names) == 0 {
+		names = []string{"all"}
+	}
+	calendars, err := cfg.SelectCalendars(names)
+	if err != nil {
+		exitf(1, "Calendar error: %v\n", err)
+	}
+	return calendars
+}
+
+// calendarColors maps the names of calendars to their colors
+func calendarColors(calendars []domain.Calendar) map[string]string {
+	colors := make(map[string]string)
+	for _, calendar := range calendars {
+		colors[calendar.Name] = calendar.Color
+	}
+	return colors
+}
+
+// outputOptions holds the --format, --columns and --template flags
+type outputOptions struct {
+	format, columns, template *string
+}
+
+// outputFlags registers --format, --columns and --template on fs
+func outputFlags(fs *flag.FlagSet) outputOptions {
+	return outputOptions{
+		format:   fs.String("format", "", "Output format (text, json, csv, tsv, ics)"),
+		columns:  fs.String("columns", "", "Comma-separated csv/tsv columns ("+strings.Join(app.EventColumns, ", ")+")"),
+		template: fs.String("template", "", "Event template, e.g. '{start-date} {start-time} {title}'"),
+	}
 }
 
-func formatter(showUID bool) *app.SimpleEventFormatter {
-	return &app.SimpleEventFormatter{ShowUID: showUID}
+// formatter resolves the output flags, falling back to the template of the
+// config for text output. Exits on invalid values.
+func formatter(cfg *config.Config, calendars []domain.Calendar, output outputOptions, showUID bool) app.EventFormatter {
+	tmpl := *output.template
+	if tmpl != "" && *output.format != "" && *output.format != app.FormatText {
+		exitf(2, "Error: --template cannot be combined with --format=%s\n", *output.format)
+	}
+	if tmpl == "" && *output.format == "" && !showUID {
+		tmpl = cfg.Defaults.Template
+	}
+
+	if tmpl != "" {
+		fmtter, err := app.NewTemplateEventFormatter(tmpl, calendarColors(calendars), displayLocation)
+		if err != nil {
+			exitf(2, "Error: %v\n", err)
+		}
+		return fmtter
+	}
+
+	var selected []string
+	if *output.columns != "" {
+		for _, column := range strings.Split(*output.columns, ",") {
+			selected = append(selected, strings.TrimSpace(column))
+		}
+	}
+	fmtter, err := app.NewEventFormatter(*output.format, selected, showUID, calendarColors(calendars), displayLocation)
+	if err != nil {
+		exitf(2, "Error: %v\n", err)
+	}
+	return fmtter
 }
 
 func main() {
@@ -73,20 +269,31 @@ func main() {
 		fmt.Fprintf(os.Stderr, "Usage: %s [flags] <command> [args]\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "\nCommands:\n")
 		fmt.Fprintf(os.Stderr, "  list        List events\n")
+		fmt.Fprintf(os.Stderr, "  agenda      List the coming days' events by day\n")
 		fmt.Fprintf(os.Stderr, "  new         Create new event\n")
 		fmt.Fprintf(os.Stderr, "  search      Search events\n")
+		fmt.Fprintf(os.Stderr, "  show        Show every detail of an event\n")
 		fmt.Fprintf(os.Stderr, "  edit        Edit existing event\n")
+		fmt.Fprintf(os.Stderr, "  delete      Delete an event or one occurrence\n")
 		fmt.Fprintf(os.Stderr, "  import      Import events from ICS file\n")
 		fmt.Fprintf(os.Stderr, "  calendars   Print available calendars\n")
-		fmt.Fprintf(os.Stderr, "  calendar    Display month calendar view\n")
+		fmt.Fprintf(os.Stderr, "  calendar    Display month (or --year) calendar view\n")
+		fmt.Fprintf(os.Stderr, "  week        Display a week as a time grid\n")
+		fmt.Fprintf(os.Stderr, "  day         Display a day as a timeline with free time\n")
 		fmt.Fprintf(os.Stderr, "  interactive Interactive TUI mode\n")
-		fmt.Fprintf(os.Stderr, "  reindex     Clear cache and force reload\n")
+		fmt.Fprintf(os.Stderr, "  reindex     Rebuild the persistent cache index\n")
+		fmt.Fprintf(os.Stderr, "  remind      Watch calendars and send reminders\n")
+		fmt.Fprintf(os.Stderr, "  todo        List and manage todos (list, new, done, edit, delete)\n")
+		fmt.Fprintf(os.Stderr, "  journal     Write and read journal entries (new, list, show)\n")
 		fmt.Fprintf(os.Stderr, "\nGlobal flags:\n")
 		flag.PrintDefaults()
 	}
 
 	version := flag.Bool("version", false, "Show version")
 	help := flag.Bool("help", false, "Show help")
+	tz := flag.String("tz", "", "Display time zone (IANA name, overrides config)")
+	cacheStats := flag.Bool("cache-stats", false, "Print cache hit/miss statistics to stderr")
+	calendarFlag(flag.CommandLine)
 
 	flag.Parse()
 
@@ -101,9 +308,25 @@ func main() {
 	}
 
 	// Initialize global cache based on config
-	cfg, _ := loadConfigAndCalendar()
+	cfg := loadConfig()
+	indexPath := config.GetDefaultIndexPath()
 	if cfg.Cache.Enabled {
-		globalCache = cache.NewEventCache(cfg.Cache.MaxSize, true)
+		globalCache = cache.NewEventCache(cfg.Cache.MaxSize, true).WithZoneResolver(ical.DeclaredZones)
+		if err := globalCache.LoadIndex(indexPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring cache index: %v\n", err)
+		}
+	}
+
+	if *tz != "" {
+		cfg.Defaults.Timezone = *tz
+	}
+	loc, err := cfg.Location()
+	if err != nil {
+		exitf(2, "Invalid time zone: %v\n", err)
+	}
+	displayLocation = loc
+	if weekStart, err = cfg.WeekStart(); err != nil {
+		exitf(1, "Config error: %v\n", err)
 	}
 
 	command := flag.Arg(0)
@@ -114,45 +337,75 @@ func main() {
 		fromFlag := listFlags.String("from", "", "Start date (YYYY-MM-DD or 'today')")
 		toFlag := listFlags.String("to", "", "End date (YYYY-MM-DD or 'today')")
 		showUIDFlag := listFlags.Bool("show-uid", false, "Show event UIDs")
+		output := outputFlags(listFlags)
+		calendarFlag(listFlags)
 		listFlags.Parse(flag.Args()[1:])
 
 		fromTime := mustParseDatePtr(*fromFlag, "from")
 		toTime := mustParseDatePtr(*toFlag, "to")
 
-		_, calendar := loadConfigAndCalendar()
-
-		// Use calendar path from config
-		reader := readerFor(calendar)
-		fmtter := formatter(*showUIDFlag)
+		// Merge events of the selected calendars
+		calendars := selectedCalendars(cfg)
+		reader := readerForAll(calendars)
+		fmtter := formatter(cfg, calendars, output, *showUIDFlag)
 		if err := app.ListHandler(reader, fmtter, os.Stdout, fromTime, toTime); err != nil {
 			exitf(1, "Error: %v\n", err)
 		}
+	case "agenda":
+		agendaFlags := flag.NewFlagSet("agenda", flag.ExitOnError)
+		fromFlag := agendaFlags.String("from", "", "First day (YYYY-MM-DD or 'today', defaults to today)")
+		daysFlag := agendaFlags.Int("days", 7, "Number of days to show")
+		calendarFlag(agendaFlags)
+		agendaFlags.Parse(flag.Args()[1:])
+
+		fromTime := mustParseDatePtr(*fromFlag, "from")
+		calendars := selectedCalendars(cfg)
+		reader := readerForAll(calendars)
+		timeProvider := &util.RealTimeProvider{Location: displayLocation}
+		layout := calendarOptions(cfg, calendars)
+		options := app.AgendaOptions{Colors: layout.Colors, Locale: layout.Locale}
+		if err := app.AgendaHandler(reader, timeProvider, os.Stdout, fromTime, *daysFlag, options); err != nil {
+			exitf(1, "Error: %v\n", err)
+		}
 	case "new":
 		newFlags := flag.NewFlagSet("new", flag.ExitOnError)
 		titleFlag := newFlags.String("title", "New Event", "Event title")
-		whenFlag := newFlags.String("when", time.Now().Format("15:04"), "Event start time")
+		whenFlag := newFlags.String("when", time.Now().In(displayLocation).Format("15:04"), "Event start time")
 		durationFlag := newFlags.String("duration", "1h", "Event duration")
 		locationFlag := newFlags.String("location", "", "Event location")
 		repeatFlag := newFlags.String("repeat", "", "Repeat pattern (daily|weekly)")
 		countFlag := newFlags.Int("count", 0, "Number of repetitions")
 		untilFlag := newFlags.String("until", "", "End date for repetition")
+		allDayFlag := newFlags.Bool("all-day", false, "Create an all-day event on the date given by --when")
+		daysFlag := newFlags.Int("days", 0, "Length of an all-day event in days (implies --all-day)")
+		var attendees, alarms stringList
+		newFlags.Var(&attendees, "attendee", "Invite an attendee, 'email' or 'Name <email>' (repeatable)")
+		organizerFlag := newFlags.String("organizer", cfg.Defaults.Organizer, "Organizer of the attendees, 'email' or 'Name <email>'")
+		newFlags.Var(&alarms, "alarm", "Remind this long before the start, e.g. 15m (repeatable)")
+		calendarFlag(newFlags)
 		newFlags.Parse(flag.Args()[1:])
 
 		title := *titleFlag
-		when := *whenFlag
-		duration := *durationFlag
-		location := *locationFlag
-		repeat := *repeatFlag
-		count := *countFlag
-		until := *untilFlag
-
-		_, calendar := loadConfigAndCalendar()
+		options := app.NewOptions{
+			Title:     title,
+			When:      *whenFlag,
+			Duration:  *durationFlag,
+			Location:  *locationFlag,
+			Repeat:    *repeatFlag,
+			Count:     *countFlag,
+			Until:     *untilFlag,
+			AllDay:    *allDayFlag,
+			Days:      *daysFlag,
+			Attendees: attendees,
+			Organizer: *organizerFlag,
+			Alarms:    alarms,
+		}
 
 		// Create writer and handle new event
-		writer := writerFor(calendar)
-		timeProvider := &util.RealTimeProvider{}
+		writer := writerFor(writableCalendar(cfg))
+		timeProvider := &util.RealTimeProvider{Location: displayLocation}
 		uidGen := &app.RealUIDGenerator{}
-		if err := app.NewHandlerWithRecurrence(writer, timeProvider, uidGen, title, when, duration, location, repeat, count, until); err != nil {
+		if err := app.NewHandlerWithOptions(writer, timeProvider, uidGen, options); err != nil {
 			exitf(1, "Error: %v\n", err)
 		}
 
@@ -161,13 +414,15 @@ func main() {
 		searchFlags := flag.NewFlagSet("search", flag.ExitOnError)
 		fieldFlag := searchFlags.String("field", "any", "Field to search in (any, title, desc, location)")
 		showUIDFlag := searchFlags.Bool("show-uid", false, "Show event UIDs")
-		searchFlags.Parse(flag.Args()[1:])
+		output := outputFlags(searchFlags)
+		calendarFlag(searchFlags)
+		args := parseArgs(searchFlags, flag.Args()[1:])
 
-		if searchFlags.NArg() < 1 {
-			exitf(2, "Usage: %s search [--field=any|title|desc|location] <query>\n", os.Args[0])
+		if len(args) < 1 {
+			exitf(2, "Usage: %s search [--field=any|title|desc|location] [--format=text|json|csv|tsv|ics] [--template=<template>] [--calendar=<name>] <query>\n", os.Args[0])
 		}
 
-		query := searchFlags.Arg(0)
+		query := args[0]
 
 		var searchField app.SearchField
 		switch *fieldFlag {
@@ -183,13 +438,31 @@ func main() {
 			exitf(2, "Invalid field: %s. Valid fields: any, title, desc, location\n", *fieldFlag)
 		}
 
-		_, calendar := loadConfigAndCalendar()
-
-		reader := readerFor(calendar)
-		fmtter := formatter(*showUIDFlag)
+		calendars := selectedCalendars(cfg)
+		reader := readerForAll(calendars)
+		fmtter := formatter(cfg, calendars, output, *showUIDFlag)
 		if err := app.SearchHandler(reader, fmtter, os.Stdout, query, searchField); err != nil {
 			exitf(1, "Error: %v\n", err)
 		}
+	case "show":
+		showFlags := flag.NewFlagSet("show", flag.ExitOnError)
+		uidFlag := showFlags.String("uid", "", "UID, or the start of a UID, of the event to show (required)")
+		nextFlag := showFlags.Int("next", 5, "Number of upcoming occurrences to list for recurring events")
+		rawFlag := showFlags.Bool("raw", false, "Print the iCalendar source of the event")
+		calendarFlag(showFlags)
+		showFlags.Parse(flag.Args()[1:])
+
+		if *uidFlag == "" {
+			exitf(2, "Usage: %s show --uid=<uid> [--next=<n>] [--raw] [--calendar=<name>]\n", os.Args[0])
+		}
+
+		calendars := allCalendarsUnlessSelected(cfg)
+		reader := readerForAll(calendars)
+		timeProvider := &util.RealTimeProvider{Location: displayLocation}
+		options := app.ShowOptions{Occurrences: *nextFlag, Raw: *rawFlag}
+		if err := app.ShowHandler(reader, writersFor(cfg), timeProvider, os.Stdout, *uidFlag, options); err != nil {
+			exitf(1, "Error: %v\n", err)
+		}
 	case "edit":
 		editFlags := flag.NewFlagSet("edit", flag.ExitOnError)
 		uidFlag := editFlags.String("uid", "", "UID of the event to edit (required)")
@@ -197,20 +470,23 @@ func main() {
 		whenFlag := editFlags.String("when", "", "New event start time")
 		durationFlag := editFlags.String("duration", "", "New event duration")
 		locationFlag := editFlags.String("location", "", "New event location")
+		var addAttendees, removeAttendees stringList
+		editFlags.Var(&addAttendees, "add-attendee", "Invite an attendee, 'email' or 'Name <email>' (repeatable)")
+		editFlags.Var(&removeAttendees, "remove-attendee", "Uninvite the attendee with this email (repeatable)")
+		organizerFlag := editFlags.String("organizer", cfg.Defaults.Organizer, "Organizer set when adding attendees to an event without one")
+		calendarFlag(editFlags)
 		editFlags.Parse(flag.Args()[1:])
 
 		if *uidFlag == "" {
-			fmt.Fprintf(os.Stderr, "Usage: %s edit --uid=<uid> [--title=<title>] [--when=<when>] [--duration=<duration>] [--location=<location>]\n", os.Args[0])
-			exitf(2, "At least one of --title, --when, --duration, or --location must be provided.\n")
+			fmt.Fprintf(os.Stderr, "Usage: %s edit --uid=<uid> [--title=<title>] [--when=<when>] [--duration=<duration>] [--location=<location>] [--add-attendee=<email>] [--remove-attendee=<email>]\n", os.Args[0])
+			exitf(2, "At least one of --title, --when, --duration, --location, --add-attendee or --remove-attendee must be provided.\n")
 		}
 
-		if *titleFlag == "" && *whenFlag == "" && *durationFlag == "" && *locationFlag == "" {
-			exitf(2, "At least one edit option must be provided: --title, --when, --duration, or --location\n")
+		if *titleFlag == "" && *whenFlag == "" && *durationFlag == "" && *locationFlag == "" && len(addAttendees) == 0 && len(removeAttendees) == 0 {
+			exitf(2, "At least one edit option must be provided: --title, --when, --duration, --location, --add-attendee or --remove-attendee\n")
 		}
 
-		_, calendar := loadConfigAndCalendar()
-
-		var options app.EditOptions
+		options := app.EditOptions{AddAttendees: addAttendees, RemoveAttendees: removeAttendees, Organizer: *organizerFlag}
 		if *titleFlag != "" {
 			options.Title = titleFlag
 		}
@@ -224,70 +500,435 @@ func main() {
 			options.Location = locationFlag
 		}
 
-		writer := writerFor(calendar)
-		timeProvider := &util.RealTimeProvider{}
+		writer := writersFor(cfg)
+		timeProvider := &util.RealTimeProvider{Location: displayLocation}
 		if err := app.EditHandler(writer, timeProvider, *uidFlag, options); err != nil {
 			exitf(1, "Error: %v\n", err)
 		}
 
 		fmt.Printf("Event '%s' updated successfully\n", *uidFlag)
-	case "import":
-		if flag.NArg() < 2 {
-			exitf(2, "Usage: %s import <file.ics>\n", os.Args[0])
+	case "delete":
+		deleteFlags := flag.NewFlagSet("delete", flag.ExitOnError)
+		uidFlag := deleteFlags.String("uid", "", "UID of the event to delete (required)")
+		occurrenceFlag := deleteFlags.String("occurrence", "", "Delete only the occurrence on this date or at this start time")
+		yesFlag := deleteFlags.Bool("yes", false, "Do not ask for confirmation")
+		calendarFlag(deleteFlags)
+		deleteFlags.Parse(flag.Args()[1:])
+
+		if *uidFlag == "" {
+			exitf(2, "Usage: %s delete --uid=<uid> [--occurrence=<date|time>] [--yes]\n", os.Args[0])
+		}
+
+		options := app.DeleteOptions{Yes: *yesFlag}
+		if *occurrenceFlag != "" {
+			options.Occurrence = occurrenceFlag
+		}
+
+		writer := writersFor(cfg)
+		timeProvider := &util.RealTimeProvider{Location: displayLocation}
+		if err := app.DeleteHandler(writer, timeProvider, os.Stdin, os.Stdout, *uidFlag, options); err != nil {
+			exitf(1, "Error: %v\n", err)
 		}
+	case "import":
+		importFlags := flag.NewFlagSet("import", flag.ExitOnError)
+		calendarFlag(importFlags)
+		args := parseArgs(importFlags, flag.Args()[1:])
 
-		filePath := flag.Arg(1)
+		if len(args) < 1 {
+			exitf(2, "Usage: %s import [--calendar=<name>] <file.ics>\n", os.Args[0])
+		}
 
-		// Load config
-		_, calendar := loadConfigAndCalendar()
+		filePath := args[0]
 
 		// Create importer and UID generator
-		writer := writerFor(calendar)
+		writer := writerFor(writableCalendar(cfg))
 		uidGen := &app.RealUIDGenerator{}
 
 		if err := app.ImportHandler(writer, uidGen, filePath, false); err != nil {
 			exitf(1, "Error: %v\n", err)
 		}
 	case "calendars":
-		cfg, _ := loadConfigAndCalendar()
-
 		if err := app.CalendarsHandler(cfg, os.Stdout); err != nil {
 			exitf(1, "Error: %v\n", err)
 		}
 	case "calendar":
 		calendarFlags := flag.NewFlagSet("calendar", flag.ExitOnError)
 		monthFlag := calendarFlags.String("month", "", "Month to display (YYYY-MM, defaults to current)")
+		yearFlag := calendarFlags.Int("year", 0, "Year to display as twelve months (YYYY)")
+		calendarFlag(calendarFlags)
 		calendarFlags.Parse(flag.Args()[1:])
 
+		if *yearFlag != 0 {
+			if *monthFlag != "" {
+				exitf(2, "--year cannot be combined with --month\n")
+			}
+			if *yearFlag < 1 || *yearFlag > 9999 {
+				exitf(2, "Invalid year %d: expected YYYY\n", *yearFlag)
+			}
+			calendars := selectedCalendars(cfg)
+			reader := readerForAll(calendars)
+			timeProvider := &util.RealTimeProvider{Location: displayLocation}
+			if err := app.YearHandler(reader, timeProvider, os.Stdout, *yearFlag, calendarOptions(cfg, calendars)); err != nil {
+				exitf(1, "Error: %v\n", err)
+			}
+			break
+		}
+
 		var targetDate *time.Time
 		if *monthFlag != "" {
-			parsed, err := time.Parse("2006-01", *monthFlag)
+			parsed, err := time.ParseInLocation("2006-01", *monthFlag, displayLocation)
 			if err != nil {
 				exitf(2, "Invalid month format: %v\n", err)
 			}
 			targetDate = &parsed
 		}
 
-		_, calendar := loadConfigAndCalendar()
-		reader := readerFor(calendar)
+		calendars := selectedCalendars(cfg)
+		reader := readerForAll(calendars)
 
-		if err := app.CalendarHandler(reader, os.Stdout, targetDate); err != nil {
+		if err := app.CalendarHandler(reader, reader, os.Stdout, targetDate, displayLocation, calendarOptions(cfg, calendars)); err != nil {
+			exitf(1, "Error: %v\n", err)
+		}
+	case "week":
+		weekFlags := flag.NewFlagSet("week", flag.ExitOnError)
+		dateFlag := weekFlags.String("date", "", "Day of the week to display (YYYY-MM-DD, 'today' or 'next-week', defaults to today)")
+		calendarFlag(weekFlags)
+		weekFlags.Parse(flag.Args()[1:])
+
+		date := mustParseDatePtr(*dateFlag, "date")
+		calendars := selectedCalendars(cfg)
+		reader := readerForAll(calendars)
+		timeProvider := &util.RealTimeProvider{Location: displayLocation}
+		if err := app.WeekHandler(reader, timeProvider, os.Stdout, date, calendarOptions(cfg, calendars)); err != nil {
+			exitf(1, "Error: %v\n", err)
+		}
+	case "day":
+		dayFlags := flag.NewFlagSet("day", flag.ExitOnError)
+		calendarFlag(dayFlags)
+		args := parseArgs(dayFlags, flag.Args()[1:])
+		if len(args) > 1 {
+			exitf(2, "Usage: calcli day [date]\n")
+		}
+
+		var date *time.Time
+		if len(args) == 1 {
+			date = mustParseDatePtr(args[0], "date")
+		}
+		firstHour, lastHour, err := cfg.WorkingHours()
+		if err != nil {
+			exitf(1, "Config error: %v\n", err)
+		}
+
+		calendars := selectedCalendars(cfg)
+		reader := readerForAll(calendars)
+		timeProvider := &util.RealTimeProvider{Location: displayLocation}
+		layout := calendarOptions(cfg, calendars)
+		options := app.DayOptions{FirstHour: firstHour, LastHour: lastHour, Width: layout.Width, Colors: layout.Colors, Locale: layout.Locale}
+		if err := app.DayHandler(reader, timeProvider, os.Stdout, date, options); err != nil {
 			exitf(1, "Error: %v\n", err)
 		}
 	case "interactive":
-		_, calendar := loadConfigAndCalendar()
-		reader := readerFor(calendar)
+		interactiveFlags := flag.NewFlagSet("interactive", flag.ExitOnError)
+		calendarFlag(interactiveFlags)
+		interactiveFlags.Parse(flag.Args()[1:])
+
+		calendars := selectedCalendars(cfg)
+		reader := readerForAll(calendars)
+		writer := writersFor(cfg)
+		firstHour, lastHour, err := cfg.WorkingHours()
+		if err != nil {
+			exitf(1, "Config error: %v\n", err)
+		}
 
-		if err := app.InteractiveHandler(reader); err != nil {
+		options := app.InteractiveOptions{
+			CalendarOptions: calendarOptions(cfg, calendars),
+			Location:        displayLocation,
+			ReadOnly:        readOnlyCalendars(calendars),
+			FirstHour:       firstHour,
+			LastHour:        lastHour,
+		}
+		if err := app.InteractiveHandler(reader, reader, writer, options); err != nil {
 			exitf(1, "Error: %v\n", err)
 		}
 	case "reindex":
-		if err := app.ReindexHandler(globalCache, os.Stdout); err != nil {
+		// Rebuild the index of every calendar
+		calendars, err := cfg.SelectCalendars([]string{"all"})
+		if err != nil {
+			exitf(1, "Calendar error: %v\n", err)
+		}
+		reader := readerForAll(calendars)
+		if err := app.ReindexHandler(globalCache, reader, os.Stdout); err != nil {
 			exitf(1, "Error: %v\n", err)
 		}
+	case "remind":
+		remindFlags := flag.NewFlagSet("remind", flag.ExitOnError)
+		intervalFlag := remindFlags.Duration("interval", time.Minute, "How often to check the calendars")
+		catchUpFlag := remindFlags.Duration("catch-up", 10*time.Minute, "Still send reminders missed by up to this long")
+		onceFlag := remindFlags.Bool("once", false, "Check once and exit, e.g. when run from cron")
+		notifyFlag := remindFlags.String("notify", "stdout", "How to send reminders (stdout, desktop, command)")
+		commandFlag := remindFlags.String("command", "", "Shell command run for each reminder with --notify command")
+		calendarFlag(remindFlags)
+		remindFlags.Parse(flag.Args()[1:])
+
+		if *intervalFlag <= 0 {
+			exitf(2, "Invalid interval: %v\n", *intervalFlag)
+		}
+
+		var notifier app.Notifier
+		switch *notifyFlag {
+		case "stdout":
+			notifier = app.StdoutNotifier{Output: os.Stdout, Location: displayLocation}
+		case "desktop":
+			desktop, err := app.NewDesktopNotifier(displayLocation)
+			if err != nil {
+				exitf(1, "Error: %v\n", err)
+			}
+			notifier = desktop
+		case "command":
+			if *commandFlag == "" {
+				exitf(2, "--notify command requires --command\n")
+			}
+			notifier = app.CommandNotifier{Command: *commandFlag, Location: displayLocation}
+		default:
+			exitf(2, "Invalid notifier: %s. Valid notifiers: stdout, desktop, command\n", *notifyFlag)
+		}
+
+		state, err := app.LoadReminderState(config.GetDefaultRemindStatePath())
+		if err != nil {
+			exitf(1, "Error: %v\n", err)
+		}
+
+		// Watch every calendar unless --calendar is given
+		calendars := allCalendarsUnlessSelected(cfg)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		options := app.RemindOptions{Interval: *intervalFlag, CatchUp: *catchUpFlag, Once: *onceFlag}
+		timeProvider := &util.RealTimeProvider{Location: displayLocation}
+		err = app.RemindHandler(ctx, readerForAll(calendars), notifier, state, timeProvider, os.Stderr, options)
+		stop()
+		if err != nil {
+			exitf(1, "Error: %v\n", err)
+		}
+	case "todo":
+		todoCommand(cfg, flag.Args()[1:])
+	case "journal":
+		journalCommand(cfg, flag.Args()[1:])
 	default:
 		fmt.Fprintf(os.Stderr, "Unknown command: %s\n\n", command)
 		flag.Usage()
 		os.Exit(2)
 	}
+
+	if globalCache != nil {
+		if err := globalCache.SaveIndex(indexPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to save cache index: %v\n", err)
+		}
+	}
+
+	if *cacheStats && globalCache != nil {
+		stats := globalCache.Stats()
+		fmt.Fprintf(os.Stderr, "Cache: %d hits, %d misses, %d evictions, %d events cached\n", stats.Hits, stats.Misses, stats.Evictions, stats.Size)
+	}
+}
+
+const todoUsage = "Usage: %s todo list [--all] [--show-uid]\n" +
+	"       %s todo new --title=<title> [--due=<date|time>] [--priority=<1-9>] [--repeat=<pattern>]\n" +
+	"       %s todo done --uid=<uid>\n" +
+	"       %s todo edit --uid=<uid> [--title=<title>] [--due=<date|time|none>] [--priority=<0-9>] [--reopen]\n" +
+	"       %s todo delete --uid=<uid> [--yes]\n"
+
+// todoCommand runs the 'todo' subcommands. Todos are read from every
+// calendar unless --calendar is given; new todos go to the default one.
+func todoCommand(cfg *config.Config, args []string) {
+	usage := func() {
+		name := os.Args[0]
+		exitf(2, todoUsage, name, name, name, name, name)
+	}
+	if len(args) == 0 {
+		usage()
+	}
+
+	timeProvider := &util.RealTimeProvider{Location: displayLocation}
+	todoFlags := flag.NewFlagSet("todo "+args[0], flag.ExitOnError)
+	calendarFlag(todoFlags)
+
+	switch args[0] {
+	case "list":
+		allFlag := todoFlags.Bool("all", false, "Include completed and cancelled todos")
+		showUIDFlag := todoFlags.Bool("show-uid", false, "Show todo UIDs")
+		todoFlags.Parse(args[1:])
+
+		reader := readerForAll(allCalendarsUnlessSelected(cfg))
+		options := app.TodoListOptions{All: *allFlag, ShowUID: *showUIDFlag}
+		if err := app.TodoListHandler(reader, timeProvider, os.Stdout, options); err != nil {
+			exitf(1, "Error: %v\n", err)
+		}
+	case "new":
+		titleFlag := todoFlags.String("title", "", "Todo title (required)")
+		descriptionFlag := todoFlags.String("description", "", "Todo description")
+		dueFlag := todoFlags.String("due", "", "Due date (YYYY-MM-DD, 'tomorrow', '+3d') or time ('YYYY-MM-DD HH:MM')")
+		priorityFlag := todoFlags.Int("priority", 0, "Priority from 1 (highest) to 9 (lowest)")
+		repeatFlag := todoFlags.String("repeat", "", "Repeat pattern (daily|weekly|monthly|yearly), needs --due")
+		countFlag := todoFlags.Int("count", 0, "Number of repetitions")
+		untilFlag := todoFlags.String("until", "", "End date for repetition")
+		todoFlags.Parse(args[1:])
+
+		if *titleFlag == "" {
+			usage()
+		}
+
+		options := app.TodoNewOptions{
+			Title:       *titleFlag,
+			Description: *descriptionFlag,
+			Due:         *dueFlag,
+			Priority:    *priorityFlag,
+			Repeat:      *repeatFlag,
+			Count:       *countFlag,
+			Until:       *untilFlag,
+		}
+
+		writer := writerFor(writableCalendar(cfg))
+		if err := app.TodoNewHandler(writer, timeProvider, &app.RealUIDGenerator{}, options); err != nil {
+			exitf(1, "Error: %v\n", err)
+		}
+
+		fmt.Printf("Todo '%s' created successfully\n", *titleFlag)
+	case "done":
+		uidFlag := todoFlags.String("uid", "", "UID of the todo to complete (required)")
+		todoFlags.Parse(args[1:])
+
+		if *uidFlag == "" {
+			usage()
+		}
+
+		if err := app.TodoDoneHandler(writersFor(cfg), timeProvider, os.Stdout, *uidFlag); err != nil {
+			exitf(1, "Error: %v\n", err)
+		}
+	case "edit":
+		uidFlag := todoFlags.String("uid", "", "UID of the todo to edit (required)")
+		titleFlag := todoFlags.String("title", "", "New todo title")
+		descriptionFlag := todoFlags.String("description", "", "New todo description")
+		dueFlag := todoFlags.String("due", "", "New due date or time, 'none' to remove it")
+		priorityFlag := todoFlags.Int("priority", -1, "New priority from 1 (highest) to 9 (lowest), 0 for none")
+		reopenFlag := todoFlags.Bool("reopen", false, "Mark a completed or cancelled todo as open again")
+		todoFlags.Parse(args[1:])
+
+		if *uidFlag == "" {
+			usage()
+		}
+
+		options := app.TodoEditOptions{Reopen: *reopenFlag}
+		if *titleFlag != "" {
+			options.Title = titleFlag
+		}
+		if *descriptionFlag != "" {
+			options.Description = descriptionFlag
+		}
+		if *dueFlag != "" {
+			options.Due = dueFlag
+		}
+		if *priorityFlag != -1 {
+			options.Priority = priorityFlag
+		}
+		if options.Title == nil && options.Description == nil && options.Due == nil && options.Priority == nil && !options.Reopen {
+			exitf(2, "At least one edit option must be provided: --title, --description, --due, --priority or --reopen\n")
+		}
+
+		if err := app.TodoEditHandler(writersFor(cfg), timeProvider, *uidFlag, options); err != nil {
+			exitf(1, "Error: %v\n", err)
+		}
+
+		fmt.Printf("Todo '%s' updated successfully\n", *uidFlag)
+	case "delete":
+		uidFlag := todoFlags.String("uid", "", "UID of the todo to delete (required)")
+		yesFlag := todoFlags.Bool("yes", false, "Do not ask for confirmation")
+		todoFlags.Parse(args[1:])
+
+		if *uidFlag == "" {
+			usage()
+		}
+
+		if err := app.TodoDeleteHandler(writersFor(cfg), os.Stdin, os.Stdout, *uidFlag, *yesFlag); err != nil {
+			exitf(1, "Error: %v\n", err)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown todo command: %s\n", args[0])
+		usage()
+	}
+}
+
+const journalUsage = "Usage: %s journal new [--title=<title>] [--text=<text|->] [--date=<date>]\n" +
+	"       %s journal list [--from=<date>] [--to=<date>] [--show-uid]\n" +
+	"       %s journal show [<date>]\n"
+
+// journalCommand runs the 'journal' subcommands. Entries are read from every
+// calendar unless --calendar is given; new entries go to the default one.
+func journalCommand(cfg *config.Config, args []string) {
+	usage := func() {
+		name := os.Args[0]
+		exitf(2, journalUsage, name, name, name)
+	}
+	if len(args) == 0 {
+		usage()
+	}
+
+	journalFlags := flag.NewFlagSet("journal "+args[0], flag.ExitOnError)
+	calendarFlag(journalFlags)
+
+	switch args[0] {
+	case "new":
+		titleFlag := journalFlags.String("title", "", "Entry title")
+		textFlag := journalFlags.String("text", "", "Entry text, '-' to read it from stdin")
+		dateFlag := journalFlags.String("date", "", "Day of the entry (YYYY-MM-DD, 'yesterday', '-2d'), defaults to today")
+		journalFlags.Parse(args[1:])
+
+		text := *textFlag
+		if text == "-" {
+			data, err := io.ReadAll(os.Stdin)
+			if err != nil {
+				exitf(1, "Error: failed to read entry text: %v\n", err)
+			}
+			text = strings.TrimRight(string(data), "\n")
+		}
+
+		options := app.JournalNewOptions{Title: *titleFlag, Text: text, Date: *dateFlag}
+		writer := writerFor(writableCalendar(cfg))
+		timeProvider := &util.RealTimeProvider{Location: displayLocation}
+		if err := app.JournalNewHandler(writer, timeProvider, &app.RealUIDGenerator{}, options); err != nil {
+			exitf(1, "Error: %v\n", err)
+		}
+
+		fmt.Println("Journal entry created successfully")
+	case "list":
+		fromFlag := journalFlags.String("from", "", "Start date (YYYY-MM-DD or 'today')")
+		toFlag := journalFlags.String("to", "", "End date (YYYY-MM-DD or 'today')")
+		showUIDFlag := journalFlags.Bool("show-uid", false, "Show entry UIDs")
+		journalFlags.Parse(args[1:])
+
+		fromTime := mustParseDatePtr(*fromFlag, "from")
+		toTime := mustParseDatePtr(*toFlag, "to")
+
+		reader := readerForAll(allCalendarsUnlessSelected(cfg))
+		if err := app.JournalListHandler(reader, os.Stdout, fromTime, toTime, displayLocation, *showUIDFlag); err != nil {
+			exitf(1, "Error: %v\n", err)
+		}
+	case "show":
+		positional := parseArgs(journalFlags, args[1:])
+		if len(positional) > 1 {
+			usage()
+		}
+
+		date := time.Now().In(displayLocation)
+		if len(positional) == 1 {
+			date = *mustParseDatePtr(positional[0], "journal")
+		}
+
+		reader := readerForAll(allCalendarsUnlessSelected(cfg))
+		if err := app.JournalShowHandler(reader, os.Stdout, date, displayLocation); err != nil {
+			exitf(1, "Error: %v\n", err)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown journal command: %s\n", args[0])
+		usage()
+	}
 }
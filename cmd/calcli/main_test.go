@@ -41,12 +41,83 @@ func TestCLI(t *testing.T) {
 			wantStdout: "Team Standup",
 			wantExit:   0,
 		},
+		{
+			name:       "list command as json",
+			args:       []string{"list", "--format", "json"},
+			wantStdout: `"summary": "Team Standup"`,
+			wantExit:   0,
+		},
+		{
+			name:       "list command with template",
+			args:       []string{"list", "--template", "{title} [{calendar}]"},
+			wantStdout: "Team Standup [home]",
+			wantExit:   0,
+		},
+		{
+			name:       "list command rejects template with json",
+			args:       []string{"list", "--format", "json", "--template", "{title}"},
+			wantStderr: "--template cannot be combined with --format=json",
+			wantExit:   1, // go run returns 1 even if os.Exit(2)
+		},
+		{
+			name:       "list command rejects unknown column",
+			args:       []string{"list", "--format", "csv", "--columns", "start,color"},
+			wantStderr: "unknown column 'color'",
+			wantExit:   1, // go run returns 1 even if os.Exit(2)
+		},
+		{
+			name:       "week command",
+			args:       []string{"week", "--date", "2025-09-01"},
+			wantStdout: "Sun 31",
+			wantExit:   0,
+		},
+		{
+			name:       "day command",
+			args:       []string{"day", "2025-09-01"},
+			wantStdout: "Mon 2025-09-01",
+			wantExit:   0,
+		},
+		{
+			name:       "day command rejects extra arguments",
+			args:       []string{"day", "2025-09-01", "2025-09-02"},
+			wantStderr: "Usage: calcli day [date]",
+			wantExit:   1, // go run returns 1 even if os.Exit(2)
+		},
+		{
+			name:       "calendar command with year",
+			args:       []string{"calendar", "--year", "2027"},
+			wantStdout: "December",
+			wantExit:   0,
+		},
+		{
+			name:       "calendar command rejects year with month",
+			args:       []string{"calendar", "--year", "2027", "--month", "2027-01"},
+			wantStderr: "--year cannot be combined with --month",
+			wantExit:   1, // go run returns 1 even if os.Exit(2)
+		},
+		{
+			name:     "agenda command",
+			args:     []string{"agenda", "--days", "3"},
+			wantExit: 0,
+		},
+		{
+			name:       "agenda command rejects no days",
+			args:       []string{"agenda", "--days", "0"},
+			wantStderr: "invalid number of days 0",
+			wantExit:   1,
+		},
 		{
 			name:       "new command",
 			args:       []string{"new"},
 			wantStdout: "Event 'New Event' created successfully",
 			wantExit:   0,
 		},
+		{
+			name:       "new command refuses read-only calendar",
+			args:       []string{"new", "--calendar", "holidays"},
+			wantStderr: "Calendar 'holidays' is read-only",
+			wantExit:   1,
+		},
 		{
 			name:       "calendars command",
 			args:       []string{"calendars"},
@@ -65,6 +136,58 @@ func TestCLI(t *testing.T) {
 			wantStderr: "Usage:",
 			wantExit:   1, // go run returns 1 even if os.Exit(2)
 		},
+		{
+			name:       "remind rejects unknown notifier",
+			args:       []string{"remind", "--once", "--notify", "pager"},
+			wantStderr: "Invalid notifier: pager",
+			wantExit:   1, // go run returns 1 even if os.Exit(2)
+		},
+		{
+			name:     "remind once",
+			args:     []string{"remind", "--once"},
+			wantExit: 0,
+		},
+		{
+			name:       "todo requires subcommand",
+			args:       []string{"todo"},
+			wantStderr: "Usage:",
+			wantExit:   1, // go run returns 1 even if os.Exit(2)
+		},
+		{
+			name:       "todo new",
+			args:       []string{"todo", "new", "--title", "Buy milk", "--due", "2025-09-01"},
+			wantStdout: "Todo 'Buy milk' created successfully",
+			wantExit:   0,
+		},
+		{
+			name:     "todo list",
+			args:     []string{"todo", "list"},
+			wantExit: 0,
+		},
+		{
+			name:       "journal new",
+			args:       []string{"journal", "new", "--title", "Standup notes", "--date", "2025-09-01"},
+			wantStdout: "Journal entry created successfully",
+			wantExit:   0,
+		},
+		{
+			name:       "journal show without entries",
+			args:       []string{"journal", "show", "2025-09-02"},
+			wantStderr: "no journal entries on 2025-09-02",
+			wantExit:   1,
+		},
+		{
+			name:       "show command",
+			args:       []string{"show", "--uid", "uid-1"},
+			wantStdout: "Team Standup",
+			wantExit:   0,
+		},
+		{
+			name:       "show command with ambiguous prefix",
+			args:       []string{"show", "--uid", "uid-"},
+			wantStderr: "UID 'uid-' is ambiguous, it matches uid-1, uid-2",
+			wantExit:   1,
+		},
 		{
 			name:       "unknown command",
 			args:       []string{"unknown"},
@@ -100,6 +223,38 @@ func TestCLI(t *testing.T) {
 	}
 }
 
+func TestCLI_PersistentIndex(t *testing.T) {
+	cfgPath, calDir, cleanup := setupTestEnv(t)
+	defer cleanup()
+
+	cfg := "{\"calendars\":{\"home\":{\"path\":\"" + escapeForJSON(calDir) + "\"}}," +
+		"\"defaults\":{\"defaultCalendar\":\"home\"},\"cache\":{\"enabled\":true}}"
+	if err := os.WriteFile(cfgPath, []byte(cfg), 0o644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	run := func(args ...string) string {
+		t.Helper()
+		cmd := exec.Command("go", append([]string{"run", "main.go"}, args...)...)
+		cmd.Env = append(os.Environ(), "CALCLI_CONFIG="+cfgPath)
+		_, stderr, exitCode := runCommand(cmd)
+		if exitCode != 0 {
+			t.Fatalf("%v exited with %d: %s", args, exitCode, stderr)
+		}
+		return stderr
+	}
+
+	if stderr := run("--cache-stats", "list"); !strings.Contains(stderr, "0 hits, 2 misses") {
+		t.Errorf("first run: stderr = %q, want 2 misses", stderr)
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(cfgPath), "cache", "index.json")); err != nil {
+		t.Fatalf("expected index to be written: %v", err)
+	}
+	if stderr := run("--cache-stats", "list"); !strings.Contains(stderr, "2 hits, 0 misses") {
+		t.Errorf("second run: stderr = %q, want 2 hits", stderr)
+	}
+}
+
 func runCommand(cmd *exec.Cmd) (stdout, stderr string, exitCode int) {
 	var outBuf, errBuf strings.Builder
 	cmd.Stdout = &outBuf
@@ -130,6 +285,11 @@ func setupTestEnv(t *testing.T) (configPath string, calendarPath string, cleanup
 		t.Fatalf("failed to create calendar dir: %v", err)
 	}
 
+	holidaysDir := filepath.Join(base, "holidays")
+	if err := os.MkdirAll(holidaysDir, 0o755); err != nil {
+		t.Fatalf("failed to create calendar dir: %v", err)
+	}
+
 	// Write two sample ICS files
 	ics1 := "" +
 		"BEGIN:VCALENDAR\n" +
@@ -168,6 +328,10 @@ func setupTestEnv(t *testing.T) (configPath string, calendarPath string, cleanup
 		"      \"path\": \"" + escapeForJSON(calDir) + "\",\n" +
 		"      \"color\": \"blue\",\n" +
 		"      \"readonly\": false\n" +
+		"    },\n" +
+		"    \"holidays\": {\n" +
+		"      \"path\": \"" + escapeForJSON(holidaysDir) + "\",\n" +
+		"      \"readonly\": true\n" +
 		"    }\n" +
 		"  },\n" +
 		"  \"defaults\": {\n" +
@@ -0,0 +1,43 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/render"
+	"github.com/NaMinhyeok/calcli/internal/util"
+)
+
+// AgendaOptions configure how the agenda names and colors days
+type AgendaOptions struct {
+	Colors map[string]string // colors of calendars by name
+	Locale render.Locale     // names of days, English when zero
+}
+
+// AgendaHandler prints the events of days days starting at from, today when
+// nil, grouped by day in the zone of timeProvider
+func AgendaHandler(reader EventReader, timeProvider util.TimeProvider, output io.Writer, from *time.Time, days int, options AgendaOptions) error {
+	if days < 1 {
+		return fmt.Errorf("invalid number of days %d: expected at least 1", days)
+	}
+
+	now := timeProvider.Now()
+	loc := now.Location()
+	start := now
+	if from != nil {
+		start = from.In(loc)
+	}
+	rangeStart := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+	rangeEnd := rangeStart.AddDate(0, 0, days).Add(-time.Second)
+
+	events, err := reader.ListEvents()
+	if err != nil {
+		return err
+	}
+
+	shown := domain.OccurrencesBetween(events, rangeStart, rangeEnd)
+	agenda := render.Agenda{From: rangeStart, Days: days, Events: shown, Now: now, Location: loc, Colors: options.Colors, Locale: options.Locale}
+	return agenda.Render(output)
+}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/render"
+)
+
+func TestAgendaHandler(t *testing.T) {
+	count := 3
+	lister := FakeEventLister{events: []domain.Event{
+		{
+			Summary:    "Standup",
+			Start:      time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC),
+			End:        time.Date(2026, 10, 15, 9, 15, 0, 0, time.UTC),
+			Recurrence: &domain.Recurrence{Frequency: "DAILY", Count: &count},
+		},
+		{
+			Summary: "Next month",
+			Start:   time.Date(2026, 11, 20, 10, 0, 0, 0, time.UTC),
+			End:     time.Date(2026, 11, 20, 11, 0, 0, 0, time.UTC),
+		},
+	}}
+	timeProvider := &StubTimeProvider{FixedTime: time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name        string
+		from        *time.Time
+		days        int
+		locale      render.Locale
+		wantLines   []string
+		unwantLines []string
+		wantErr     bool
+	}{
+		{
+			name:        "from today",
+			days:        7,
+			wantLines:   []string{"Today, Fri 2026-10-16", "Tomorrow, Sat 2026-10-17"},
+			unwantLines: []string{"Thu 2026-10-15", "Next month"},
+		},
+		{
+			name:        "single day",
+			days:        1,
+			wantLines:   []string{"Today, Fri 2026-10-16"},
+			unwantLines: []string{"2026-10-17"},
+		},
+		{
+			name:      "from a date",
+			from:      timePtr(time.Date(2026, 11, 20, 0, 0, 0, 0, time.UTC)),
+			days:      1,
+			wantLines: []string{"Fri 2026-11-20", "10:00-11:00 Next month"},
+		},
+		{
+			name:        "localized headings",
+			days:        2,
+			locale:      mustLocale(t, "de"),
+			wantLines:   []string{"Heute, Fr 2026-10-16", "Morgen, Sa 2026-10-17"},
+			unwantLines: []string{"Today", "Fri"},
+		},
+		{
+			name:    "no days",
+			days:    0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := AgendaHandler(lister, timeProvider, &buf, tt.from, tt.days, AgendaOptions{Locale: tt.locale})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, want := range tt.wantLines {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("expected %q in output:\n%s", want, buf.String())
+				}
+			}
+			for _, unwant := range tt.unwantLines {
+				if strings.Contains(buf.String(), unwant) {
+					t.Errorf("did not expect %q in output:\n%s", unwant, buf.String())
+				}
+			}
+		})
+	}
+}
+
+func mustLocale(t *testing.T, name string) render.Locale {
+	t.Helper()
+	locale, err := render.LookupLocale(name)
+	if err != nil {
+		t.Fatalf("failed to look up locale %s: %v", name, err)
+	}
+	return locale
+}
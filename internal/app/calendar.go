@@ -6,6 +6,7 @@ import (
 
 	"github.com/NaMinhyeok/calcli/internal/domain"
 	"github.com/NaMinhyeok/calcli/internal/render"
+	"github.com/NaMinhyeok/calcli/internal/util"
 )
 
 // EventReader interface for reading events
@@ -13,16 +14,31 @@ type EventReader interface {
 	ListEvents() ([]domain.Event, error)
 }
 
-// CalendarHandler displays a month calendar view with events
-func CalendarHandler(reader EventReader, output io.Writer, date *time.Time) error {
+// CalendarOptions configure how calendar views lay out, name and color
+// days
+type CalendarOptions struct {
+	WeekStart       time.Weekday
+	Locale          render.Locale // English when zero
+	ShowWeekNumbers bool
+	Colors          map[string]string // colors of calendars by name
+	Width           int               // width of year and week views in cells
+}
+
+// CalendarHandler displays a month calendar view with events in loc, laid
+// out by options. Days with entries of journals, when not nil, are marked.
+func CalendarHandler(reader EventReader, journals JournalLister, output io.Writer, date *time.Time, loc *time.Location, options CalendarOptions) error {
+	if loc == nil {
+		loc = time.Local
+	}
+
 	// Default to current month if no date provided
-	targetDate := time.Now()
+	targetDate := time.Now().In(loc)
 	if date != nil {
 		targetDate = *date
 	}
 
 	// Get all events for the month
-	firstDay := time.Date(targetDate.Year(), targetDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	firstDay := time.Date(targetDate.Year(), targetDate.Month(), 1, 0, 0, 0, 0, loc)
 	lastDay := firstDay.AddDate(0, 1, 0).Add(-time.Second)
 
 	events, err := reader.ListEvents()
@@ -30,22 +46,42 @@ func CalendarHandler(reader EventReader, output io.Writer, date *time.Time) erro
 		return err
 	}
 
-	// Filter events for this month
-	var monthEvents []domain.Event
-	for _, event := range events {
-		if !event.Start.Before(firstDay) && !event.Start.After(lastDay) {
-			monthEvents = append(monthEvents, event)
+	// Render calendar with the instances covering the month
+	view := render.NewMonthView(firstDay, domain.OccurrencesBetween(events, firstDay, lastDay))
+	view.Colors = options.Colors
+	view.WeekStart = options.WeekStart
+	view.Locale = options.Locale
+	view.ShowWeekNumbers = options.ShowWeekNumbers
+	if journals != nil {
+		entries, err := journals.ListJournals()
+		if err != nil {
+			return err
 		}
+		view.Journals = entries
 	}
+	return view.RenderWithEvents(output)
+}
 
-	// Expand recurring events within the month
-	var expandedEvents []domain.Event
-	for _, event := range monthEvents {
-		instances := domain.ExpandRecurrence(event, firstDay, lastDay)
-		expandedEvents = append(expandedEvents, instances...)
+// YearHandler displays the twelve months of year, shading days by their
+// number of events in the zone of timeProvider and marking today, laid out
+// by options
+func YearHandler(reader EventReader, timeProvider util.TimeProvider, output io.Writer, year int, options CalendarOptions) error {
+	now := timeProvider.Now()
+	loc := now.Location()
+	firstDay := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	lastDay := firstDay.AddDate(1, 0, 0).Add(-time.Second)
+
+	events, err := reader.ListEvents()
+	if err != nil {
+		return err
 	}
 
-	// Render calendar
-	view := render.NewMonthView(targetDate, expandedEvents)
-	return view.RenderWithEvents(output)
+	view := render.NewYearView(firstDay, domain.OccurrencesBetween(events, firstDay, lastDay))
+	view.Today = now
+	view.Width = options.Width
+	view.Colors = options.Colors
+	view.WeekStart = options.WeekStart
+	view.Locale = options.Locale
+	view.ShowWeekNumbers = options.ShowWeekNumbers
+	return view.Render(output)
 }
@@ -0,0 +1,64 @@
+package app
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/render"
+)
+
+func TestYearHandler(t *testing.T) {
+	lister := FakeEventLister{events: []domain.Event{
+		{
+			Summary:    "Monthly review",
+			Start:      time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC),
+			End:        time.Date(2026, 1, 15, 11, 0, 0, 0, time.UTC),
+			Recurrence: &domain.Recurrence{Frequency: "MONTHLY"},
+		},
+	}}
+
+	timeProvider := &StubTimeProvider{FixedTime: time.Date(2027, 3, 10, 8, 0, 0, 0, time.UTC)}
+	var buf bytes.Buffer
+	if err := YearHandler(lister, timeProvider, &buf, 2027, CalendarOptions{Width: 120}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, "2027") {
+		t.Errorf("expected the year in the output:\n%s", output)
+	}
+	// Every month of the year has its review shaded
+	if got := strings.Count(output, "15░"); got != 12 {
+		t.Errorf("expected 12 shaded days, got %d:\n%s", got, output)
+	}
+	if !strings.Contains(output, "10*") {
+		t.Errorf("expected today to be marked:\n%s", output)
+	}
+}
+
+func TestCalendarHandler_Options(t *testing.T) {
+	lister := FakeEventLister{events: []domain.Event{
+		{
+			Summary: "Kickoff",
+			Start:   time.Date(2027, 1, 4, 9, 0, 0, 0, time.UTC),
+			End:     time.Date(2027, 1, 4, 10, 0, 0, 0, time.UTC),
+		},
+	}}
+
+	date := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	options := CalendarOptions{WeekStart: time.Monday, Locale: render.English, ShowWeekNumbers: true}
+	var buf bytes.Buffer
+	if err := CalendarHandler(lister, nil, &buf, &date, time.UTC, options); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{"Wk  Mo  Tu  We  Th  Fr  Sa  Su", "Kickoff"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q:\n%s", want, output)
+		}
+	}
+}
@@ -8,8 +8,8 @@ import (
 )
 
 func CalendarsHandler(cfg *config.Config, output io.Writer) error {
-	for name, calendar := range cfg.Calendars {
-		fmt.Fprintf(output, "%s: %s\n", name, calendar.Path)
+	for _, name := range cfg.CalendarNames() {
+		fmt.Fprintf(output, "%s: %s\n", name, cfg.Calendars[name].Path)
 	}
 	return nil
 }
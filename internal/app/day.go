@@ -0,0 +1,45 @@
+package app
+
+import (
+	"io"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/render"
+	"github.com/NaMinhyeok/calcli/internal/util"
+)
+
+type DayOptions struct {
+	FirstHour int // working hours, the render defaults when both are zero
+	LastHour  int
+	Width     int               // width of the timeline in cells
+	Colors    map[string]string // colors of calendars by name
+	Locale    render.Locale     // names of days, English when zero
+}
+
+// DayHandler displays date, today when nil, as a timeline in the zone of
+// timeProvider, marking the current time and listing free time
+func DayHandler(reader EventReader, timeProvider util.TimeProvider, output io.Writer, date *time.Time, options DayOptions) error {
+	now := timeProvider.Now()
+	loc := now.Location()
+	day := now
+	if date != nil {
+		day = date.In(loc)
+	}
+	dayStart := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+	dayEnd := dayStart.AddDate(0, 0, 1).Add(-time.Second)
+
+	events, err := reader.ListEvents()
+	if err != nil {
+		return err
+	}
+
+	view := render.NewDayView(dayStart, domain.OccurrencesBetween(events, dayStart, dayEnd))
+	view.Now = now
+	view.FirstHour = options.FirstHour
+	view.LastHour = options.LastHour
+	view.Width = options.Width
+	view.Colors = options.Colors
+	view.Locale = options.Locale
+	return view.Render(output)
+}
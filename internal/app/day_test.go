@@ -0,0 +1,85 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+func TestDayHandler(t *testing.T) {
+	count := 5
+	lister := FakeEventLister{events: []domain.Event{
+		{
+			Summary:    "Standup",
+			Start:      time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC),
+			End:        time.Date(2026, 10, 14, 9, 30, 0, 0, time.UTC),
+			Recurrence: &domain.Recurrence{Frequency: "DAILY", Count: &count},
+		},
+		{
+			Summary: "Tomorrow",
+			Start:   time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC),
+			End:     time.Date(2026, 10, 17, 11, 0, 0, 0, time.UTC),
+		},
+	}}
+	timeProvider := &StubTimeProvider{FixedTime: time.Date(2026, 10, 16, 10, 15, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name        string
+		date        *time.Time
+		options     DayOptions
+		wantLines   []string
+		unwantLines []string
+	}{
+		{
+			name:        "today",
+			options:     DayOptions{FirstHour: 9, LastHour: 12},
+			wantLines:   []string{"Today, Fri 2026-10-16", "09:00-09:30 Standup (30m)", "10:15 ", " now", "09:30-12:00 (2h30m)"},
+			unwantLines: []string{"Tomorrow"},
+		},
+		{
+			name:        "another day",
+			date:        timePtr(time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)),
+			wantLines:   []string{"Tomorrow, Sat 2026-10-17", "10:00-11:00 Tomorrow (1h)", "08:00-09:00 (1h)", "11:00-18:00 (7h)"},
+			unwantLines: []string{" now"},
+		},
+		{
+			name:        "localized heading",
+			options:     DayOptions{Locale: mustLocale(t, "fr")},
+			wantLines:   []string{"Aujourd'hui, ven 2026-10-16"},
+			unwantLines: []string{"Today", "Fri"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := DayHandler(lister, timeProvider, &buf, tt.date, tt.options); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			output := buf.String()
+			for _, want := range tt.wantLines {
+				if !strings.Contains(output, want) {
+					t.Errorf("expected output to contain %q:\n%s", want, output)
+				}
+			}
+			for _, unwant := range tt.unwantLines {
+				if strings.Contains(output, unwant) {
+					t.Errorf("expected output not to contain %q:\n%s", unwant, output)
+				}
+			}
+		})
+	}
+}
+
+func TestDayHandler_Error(t *testing.T) {
+	lister := FakeEventLister{err: errors.New("permission denied")}
+	timeProvider := &StubTimeProvider{FixedTime: time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC)}
+	if err := DayHandler(lister, timeProvider, &bytes.Buffer{}, nil, DayOptions{}); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,121 @@
+package app
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/util"
+)
+
+type EventDeleter interface {
+	EventEditor
+	DeleteEvent(uid string) error
+}
+
+type DeleteOptions struct {
+	Occurrence *string // date or start time of a single occurrence to delete
+	Yes        bool    // skip the confirmation prompt
+}
+
+// DeleteHandler deletes an event, or one occurrence of a recurring series by
+// adding it to EXDATE. Unless options.Yes is set, it asks for confirmation
+// on input first.
+func DeleteHandler(deleter EventDeleter, timeProvider util.TimeProvider, input io.Reader, output io.Writer, uid string, options DeleteOptions) error {
+	// Basic UID validation
+	if len(uid) < 3 {
+		return fmt.Errorf("UID '%s' is too short. UIDs should be at least 3 characters long", uid)
+	}
+
+	event, err := deleter.FindEventByUID(uid)
+	if err != nil {
+		return fmt.Errorf("no event found with UID '%s'. Use 'calcli list --show-uid' or 'calcli search --show-uid <query>' to find valid UIDs", uid)
+	}
+
+	if options.Occurrence != nil {
+		occurrence, err := findOccurrence(event, *options.Occurrence, timeProvider)
+		if err != nil {
+			return err
+		}
+
+		label := occurrence.In(timeProvider.Now().Location()).Format("2006-01-02 15:04")
+		if event.AllDay {
+			label = occurrence.Format("2006-01-02")
+		}
+
+		question := fmt.Sprintf("Delete the %s occurrence of '%s'?", label, event.Summary)
+		if !options.Yes && !confirm(input, output, question) {
+			fmt.Fprintln(output, "Cancelled.")
+			return nil
+		}
+
+		if err := deleter.UpdateEvent(event.ExcludeOccurrence(occurrence)); err != nil {
+			return fmt.Errorf("failed to delete occurrence: %w", err)
+		}
+
+		fmt.Fprintf(output, "Occurrence %s of '%s' deleted\n", label, event.Summary)
+		return nil
+	}
+
+	question := fmt.Sprintf("Delete event '%s'?", event.Summary)
+	if event.IsRecurring() {
+		question = fmt.Sprintf("Delete all occurrences of '%s'?", event.Summary)
+	}
+	if !options.Yes && !confirm(input, output, question) {
+		fmt.Fprintln(output, "Cancelled.")
+		return nil
+	}
+
+	if err := deleter.DeleteEvent(uid); err != nil {
+		return fmt.Errorf("failed to delete event: %w", err)
+	}
+
+	fmt.Fprintf(output, "Event '%s' deleted\n", event.Summary)
+	return nil
+}
+
+// findOccurrence returns the original start of the occurrence of a series
+// at when. A start time must match exactly; a date selects the first
+// occurrence on that day.
+func findOccurrence(event domain.Event, when string, timeProvider util.TimeProvider) (time.Time, error) {
+	if !event.IsRecurring() {
+		return time.Time{}, fmt.Errorf("event '%s' is not recurring", event.UID)
+	}
+
+	if start, err := util.ParseTime(when, timeProvider); err == nil {
+		for _, instance := range domain.ExpandRecurrence(event, start, start) {
+			if instance.Start.Equal(start) || instance.OriginalStart().Equal(start) {
+				return instance.OriginalStart(), nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("no occurrence of '%s' starts at %s", event.Summary, when)
+	}
+
+	loc := timeProvider.Now().Location()
+	day, err := util.ParseDateIn(when, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid occurrence: %v", err)
+	}
+	dayEnd := day.AddDate(0, 0, 1).Add(-time.Second)
+
+	for _, instance := range domain.ExpandRecurrence(event, day, dayEnd) {
+		start := instance.In(loc).Start
+		if !start.Before(day) && !start.After(dayEnd) {
+			return instance.OriginalStart(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("no occurrence of '%s' on %s", event.Summary, day.Format("2006-01-02"))
+}
+
+// confirm asks a yes/no question on output and reads the answer from
+// input, defaulting to no
+func confirm(input io.Reader, output io.Writer, question string) bool {
+	fmt.Fprintf(output, "%s [y/N]: ", question)
+
+	answer, _ := bufio.NewReader(input).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
@@ -0,0 +1,183 @@
+package app
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+type FakeEventDeleter struct {
+	*FakeEventEditor
+}
+
+func NewFakeEventDeleter() *FakeEventDeleter {
+	return &FakeEventDeleter{FakeEventEditor: NewFakeEventEditor()}
+}
+
+func (f *FakeEventDeleter) DeleteEvent(uid string) error {
+	if f.err != nil {
+		return f.err
+	}
+
+	delete(f.events, uid)
+	return nil
+}
+
+func TestDeleteHandler(t *testing.T) {
+	single := domain.Event{
+		UID:     "single-1",
+		Summary: "Dentist",
+		Start:   time.Date(2025, 9, 3, 10, 0, 0, 0, time.Local),
+		End:     time.Date(2025, 9, 3, 11, 0, 0, 0, time.Local),
+	}
+	count := 5
+	moved := time.Date(2025, 9, 2, 9, 0, 0, 0, time.Local)
+	series := domain.Event{
+		UID:        "series-1",
+		Summary:    "Standup",
+		Start:      time.Date(2025, 9, 1, 9, 0, 0, 0, time.Local),
+		End:        time.Date(2025, 9, 1, 9, 15, 0, 0, time.Local),
+		Recurrence: &domain.Recurrence{Frequency: "DAILY", Interval: 1, Count: &count},
+		Overrides: []domain.Event{
+			{
+				UID:          "series-1",
+				Summary:      "Standup (late)",
+				Start:        time.Date(2025, 9, 2, 11, 0, 0, 0, time.Local),
+				End:          time.Date(2025, 9, 2, 11, 15, 0, 0, time.Local),
+				RecurrenceID: &moved,
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		uid            string
+		input          string
+		options        DeleteOptions
+		expectError    bool
+		expectDeleted  bool
+		expectExDate   *time.Time
+		expectOverride bool
+		expectOutput   string
+	}{
+		{
+			name:          "confirmed delete",
+			uid:           "single-1",
+			input:         "y\n",
+			expectDeleted: true,
+			expectOutput:  "Delete event 'Dentist'? [y/N]: Event 'Dentist' deleted\n",
+		},
+		{
+			name:         "declined delete",
+			uid:          "single-1",
+			input:        "\n",
+			expectOutput: "Delete event 'Dentist'? [y/N]: Cancelled.\n",
+		},
+		{
+			name:          "yes skips prompt",
+			uid:           "single-1",
+			options:       DeleteOptions{Yes: true},
+			expectDeleted: true,
+			expectOutput:  "Event 'Dentist' deleted\n",
+		},
+		{
+			name:          "whole series",
+			uid:           "series-1",
+			input:         "yes\n",
+			expectDeleted: true,
+			expectOutput:  "Delete all occurrences of 'Standup'? [y/N]: Event 'Standup' deleted\n",
+		},
+		{
+			name:           "occurrence by date",
+			uid:            "series-1",
+			options:        DeleteOptions{Occurrence: stringPtr("2025-09-03"), Yes: true},
+			expectExDate:   timePtr(time.Date(2025, 9, 3, 9, 0, 0, 0, time.Local)),
+			expectOverride: true,
+			expectOutput:   "Occurrence 2025-09-03 09:00 of 'Standup' deleted\n",
+		},
+		{
+			name:           "occurrence by start time",
+			uid:            "series-1",
+			options:        DeleteOptions{Occurrence: stringPtr("2025-09-04 09:00"), Yes: true},
+			expectExDate:   timePtr(time.Date(2025, 9, 4, 9, 0, 0, 0, time.Local)),
+			expectOverride: true,
+			expectOutput:   "Occurrence 2025-09-04 09:00 of 'Standup' deleted\n",
+		},
+		{
+			name:         "overridden occurrence drops override",
+			uid:          "series-1",
+			options:      DeleteOptions{Occurrence: stringPtr("2025-09-02"), Yes: true},
+			expectExDate: timePtr(moved),
+			expectOutput: "Occurrence 2025-09-02 09:00 of 'Standup' deleted\n",
+		},
+		{
+			name:        "no occurrence on date",
+			uid:         "series-1",
+			options:     DeleteOptions{Occurrence: stringPtr("2025-10-01"), Yes: true},
+			expectError: true,
+		},
+		{
+			name:        "occurrence of non-recurring event",
+			uid:         "single-1",
+			options:     DeleteOptions{Occurrence: stringPtr("2025-09-03"), Yes: true},
+			expectError: true,
+		},
+		{
+			name:        "event not found",
+			uid:         "nonexistent",
+			options:     DeleteOptions{Yes: true},
+			expectError: true,
+		},
+		{
+			name:        "UID too short",
+			uid:         "x",
+			options:     DeleteOptions{Yes: true},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleter := NewFakeEventDeleter()
+			deleter.AddEvent(single)
+			deleter.AddEvent(series)
+
+			timeProvider := &StubTimeProvider{FixedTime: time.Date(2025, 8, 29, 10, 30, 0, 0, time.Local)}
+			var output bytes.Buffer
+
+			err := DeleteHandler(deleter, timeProvider, strings.NewReader(tt.input), &output, tt.uid, tt.options)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if output.String() != tt.expectOutput {
+				t.Errorf("expected output %q, got %q", tt.expectOutput, output.String())
+			}
+
+			_, exists := deleter.events[tt.uid]
+			if tt.expectDeleted == exists {
+				t.Errorf("expected deleted=%v, event still present=%v", tt.expectDeleted, exists)
+			}
+
+			if tt.expectExDate != nil {
+				updated := deleter.events[tt.uid]
+				if len(updated.ExDates) != 1 || !updated.ExDates[0].Equal(*tt.expectExDate) {
+					t.Errorf("expected EXDATE %v, got %v", *tt.expectExDate, updated.ExDates)
+				}
+				if (len(updated.Overrides) == 1) != tt.expectOverride {
+					t.Errorf("expected override kept=%v, got %d overrides", tt.expectOverride, len(updated.Overrides))
+				}
+			}
+		})
+	}
+}
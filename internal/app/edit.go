@@ -13,10 +13,13 @@ type EventEditor interface {
 }
 
 type EditOptions struct {
-	Title    *string
-	When     *string
-	Duration *string
-	Location *string
+	Title           *string
+	When            *string
+	Duration        *string
+	Location        *string
+	AddAttendees    []string // "bob@example.com" or "Bob <bob@example.com>"
+	RemoveAttendees []string // email addresses
+	Organizer       string   // organizer set when adding attendees to an event without one
 }
 
 func EditHandler(editor EventEditor, timeProvider util.TimeProvider, uid string, options EditOptions) error {
@@ -34,7 +37,17 @@ func EditHandler(editor EventEditor, timeProvider util.TimeProvider, uid string,
 		event.Summary = *options.Title
 	}
 
-	if options.When != nil {
+	if options.When != nil && event.AllDay {
+		date, err := parseAllDayDate(*options.When, timeProvider)
+		if err != nil {
+			return fmt.Errorf("invalid date format: %v", err)
+		}
+
+		// Keep the number of days an all-day event spans
+		days := len(event.Dates())
+		event.Start = date
+		event.End = date.AddDate(0, 0, days)
+	} else if options.When != nil {
 		startTime, err := util.ParseTime(*options.When, timeProvider)
 		if err != nil {
 			return fmt.Errorf("invalid time format: %v", err)
@@ -46,6 +59,9 @@ func EditHandler(editor EventEditor, timeProvider util.TimeProvider, uid string,
 	}
 
 	if options.Duration != nil {
+		if event.AllDay {
+			return fmt.Errorf("cannot set a duration on an all-day event")
+		}
 		dur, err := util.ParseDuration(*options.Duration)
 		if err != nil {
 			return fmt.Errorf("invalid duration: %v", err)
@@ -57,9 +73,34 @@ func EditHandler(editor EventEditor, timeProvider util.TimeProvider, uid string,
 		event.Location = *options.Location
 	}
 
+	if err := removeAttendees(&event, options.RemoveAttendees); err != nil {
+		return err
+	}
+	if err := addAttendees(&event, options.AddAttendees, options.Organizer); err != nil {
+		return err
+	}
+
 	if err := editor.UpdateEvent(event); err != nil {
-		return fmt.Errorf("failed to update event: %v", err)
+		return fmt.Errorf("failed to update event: %w", err)
 	}
 
 	return nil
 }
+
+// removeAttendees uninvites the attendees with the given email addresses
+func removeAttendees(event *domain.Event, emails []string) error {
+	if len(emails) == 0 {
+		return nil
+	}
+
+	attendees := append([]domain.Attendee(nil), event.Attendees...)
+	for _, email := range emails {
+		i := findAttendee(attendees, email)
+		if i < 0 {
+			return fmt.Errorf("%s is not an attendee of '%s'", email, event.Summary)
+		}
+		attendees = append(attendees[:i], attendees[i+1:]...)
+	}
+	event.Attendees = attendees
+	return nil
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -182,6 +183,91 @@ func TestEditHandler(t *testing.T) {
 	}
 }
 
+func TestEditHandler_Attendees(t *testing.T) {
+	bob := domain.Attendee{Email: "bob@example.com", Name: "Bob", Status: "ACCEPTED"}
+	carol := domain.Attendee{Email: "carol@example.com", Role: "REQ-PARTICIPANT", Status: "NEEDS-ACTION", RSVP: true}
+	originalEvent := domain.Event{
+		UID:       "meeting-1",
+		Summary:   "Review",
+		Start:     time.Date(2025, 9, 3, 10, 0, 0, 0, time.UTC),
+		End:       time.Date(2025, 9, 3, 11, 0, 0, 0, time.UTC),
+		Attendees: []domain.Attendee{bob},
+	}
+
+	organizer := "Alice <alice@example.com>"
+
+	tests := []struct {
+		name        string
+		options     EditOptions
+		expected    []domain.Attendee
+		expectError bool
+	}{
+		{
+			name:     "add attendee",
+			options:  EditOptions{AddAttendees: []string{"carol@example.com"}, Organizer: organizer},
+			expected: []domain.Attendee{bob, carol},
+		},
+		{
+			name:        "add attendee without organizer",
+			options:     EditOptions{AddAttendees: []string{"carol@example.com"}},
+			expectError: true,
+		},
+		{
+			name:     "remove attendee ignoring case",
+			options:  EditOptions{RemoveAttendees: []string{"BOB@example.com"}},
+			expected: []domain.Attendee{},
+		},
+		{
+			name:     "replace attendee",
+			options:  EditOptions{RemoveAttendees: []string{"bob@example.com"}, AddAttendees: []string{"carol@example.com"}, Organizer: organizer},
+			expected: []domain.Attendee{carol},
+		},
+		{
+			name:        "add existing attendee",
+			options:     EditOptions{AddAttendees: []string{"Bob <bob@example.com>"}, Organizer: organizer},
+			expectError: true,
+		},
+		{
+			name:        "remove unknown attendee",
+			options:     EditOptions{RemoveAttendees: []string{"dave@example.com"}},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			editor := NewFakeEventEditor()
+			editor.AddEvent(originalEvent)
+			timeProvider := &StubTimeProvider{FixedTime: time.Date(2025, 8, 29, 10, 30, 0, 0, time.Local)}
+
+			err := EditHandler(editor, timeProvider, "meeting-1", tt.options)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("expected error but got none")
+				}
+				if !slices.Equal(editor.events["meeting-1"].Attendees, originalEvent.Attendees) {
+					t.Errorf("expected attendees to be unchanged, got %+v", editor.events["meeting-1"].Attendees)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if !slices.Equal(editor.events["meeting-1"].Attendees, tt.expected) {
+				t.Errorf("expected attendees %+v, got %+v", tt.expected, editor.events["meeting-1"].Attendees)
+			}
+			if len(tt.options.AddAttendees) > 0 {
+				expected := domain.Attendee{Email: "alice@example.com", Name: "Alice"}
+				if got := editor.events["meeting-1"].Organizer; got == nil || *got != expected {
+					t.Errorf("expected organizer %+v, got %+v", expected, got)
+				}
+			}
+		})
+	}
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
@@ -0,0 +1,208 @@
+package app
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/ical"
+)
+
+// Output formats of list and search
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+	FormatTSV  = "tsv"
+	FormatICS  = "ics"
+)
+
+// EventColumns are the columns CSV and TSV output can select
+var EventColumns = []string{"uid", "calendar", "summary", "description", "location", "start", "end", "all_day", "recurrence"}
+
+// DefaultEventColumns are written when no columns are selected
+var DefaultEventColumns = []string{"start", "end", "summary", "location", "calendar", "uid"}
+
+// NewEventFormatter returns the formatter of format, displaying times in
+// loc. columns selects the CSV and TSV columns; showUID and the calendar
+// colors apply to text.
+func NewEventFormatter(format string, columns []string, showUID bool, colors map[string]string, loc *time.Location) (EventFormatter, error) {
+	if len(columns) > 0 && format != FormatCSV && format != FormatTSV {
+		return nil, fmt.Errorf("columns can only be selected for csv and tsv output")
+	}
+
+	switch format {
+	case "", FormatText:
+		return SimpleEventFormatter{ShowUID: showUID, Colors: colors, Location: loc}, nil
+	case FormatJSON:
+		return JSONEventFormatter{Location: loc}, nil
+	case FormatCSV, FormatTSV:
+		if len(columns) == 0 {
+			columns = DefaultEventColumns
+		}
+		for _, column := range columns {
+			if !slices.Contains(EventColumns, column) {
+				return nil, fmt.Errorf("unknown column '%s'. Valid columns: %s", column, strings.Join(EventColumns, ", "))
+			}
+		}
+		separator := ','
+		if format == FormatTSV {
+			separator = '\t'
+		}
+		return DelimitedEventFormatter{Separator: separator, Columns: columns, Location: loc}, nil
+	case FormatICS:
+		return ICSEventFormatter{}, nil
+	}
+	return nil, fmt.Errorf("unknown format '%s'. Valid formats: text, json, csv, tsv, ics", format)
+}
+
+// jsonEvent is the JSON schema of an event. Every key is always present;
+// recurrenceId is set on the occurrences of a recurring event.
+type jsonEvent struct {
+	UID          string  `json:"uid"`
+	Calendar     string  `json:"calendar"`
+	Summary      string  `json:"summary"`
+	Description  string  `json:"description"`
+	Location     string  `json:"location"`
+	Start        string  `json:"start"`
+	End          string  `json:"end"`
+	AllDay       bool    `json:"allDay"`
+	Recurrence   *string `json:"recurrence"`
+	RecurrenceID *string `json:"recurrenceId"`
+}
+
+// JSONEventFormatter writes events as a JSON array, with times in RFC 3339
+type JSONEventFormatter struct {
+	Location *time.Location // display zone, event zone when nil
+}
+
+func (j JSONEventFormatter) FormatEvents(events []domain.Event, w io.Writer) error {
+	out := make([]jsonEvent, 0, len(events))
+	for _, event := range events {
+		shown := event.In(j.Location)
+		item := jsonEvent{
+			UID:         event.UID,
+			Calendar:    event.Calendar,
+			Summary:     event.Summary,
+			Description: event.Description,
+			Location:    event.Location,
+			Start:       shown.Start.Format(time.RFC3339),
+			End:         shown.EndTime().Format(time.RFC3339),
+			AllDay:      event.AllDay,
+		}
+		if rule := ical.FormatRRULE(seriesRule(event), event); rule != "" {
+			item.Recurrence = &rule
+		}
+		if event.RecurrenceID != nil {
+			original := originalStart(event, j.Location).Format(time.RFC3339)
+			item.RecurrenceID = &original
+		}
+		out = append(out, item)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(out)
+}
+
+// DelimitedEventFormatter writes events as CSV, or TSV with a tab
+// separator, starting with a header row
+type DelimitedEventFormatter struct {
+	Separator rune
+	Columns   []string       // from EventColumns
+	Location  *time.Location // display zone, event zone when nil
+}
+
+func (d DelimitedEventFormatter) FormatEvents(events []domain.Event, w io.Writer) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = d.Separator
+	writer.Write(d.Columns)
+
+	for _, event := range events {
+		shown := event.In(d.Location)
+		record := make([]string, len(d.Columns))
+		for i, column := range d.Columns {
+			record[i] = columnValue(shown, column)
+		}
+		writer.Write(record)
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func columnValue(event domain.Event, column string) string {
+	switch column {
+	case "uid":
+		return event.UID
+	case "calendar":
+		return event.Calendar
+	case "summary":
+		return event.Summary
+	case "description":
+		return event.Description
+	case "location":
+		return event.Location
+	case "start":
+		return event.Start.Format(time.RFC3339)
+	case "end":
+		return event.EndTime().Format(time.RFC3339)
+	case "all_day":
+		return strconv.FormatBool(event.AllDay)
+	case "recurrence":
+		return ical.FormatRRULE(seriesRule(event), event)
+	}
+	return ""
+}
+
+// ICSEventFormatter writes events as a single VCALENDAR. Occurrences of a
+// recurring event are written as standalone events, without RECURRENCE-ID
+// and with their original start appended to the UID, since the output does
+// not hold the series they would otherwise override.
+type ICSEventFormatter struct{}
+
+func (ICSEventFormatter) FormatEvents(events []domain.Event, w io.Writer) error {
+	standalone := make([]domain.Event, len(events))
+	for i, event := range events {
+		if event.RecurrenceID != nil {
+			event.UID = occurrenceUID(event)
+			event.RecurrenceID = nil
+			event.SeriesRule = nil
+		}
+		standalone[i] = event
+	}
+	return ical.GenerateEvents(standalone, w)
+}
+
+// occurrenceUID returns the UID of an occurrence written on its own, e.g.
+// "standup-1-20250902T000000Z"
+func occurrenceUID(event domain.Event) string {
+	original := *event.RecurrenceID
+	switch {
+	case event.AllDay:
+		return event.UID + "-" + original.Format("20060102")
+	case event.Floating:
+		return event.UID + "-" + original.Format("20060102T150405")
+	}
+	return event.UID + "-" + original.UTC().Format("20060102T150405Z")
+}
+
+// seriesRule returns the recurrence of a series or of the series an
+// occurrence was expanded from
+func seriesRule(event domain.Event) *domain.Recurrence {
+	if event.Recurrence != nil {
+		return event.Recurrence
+	}
+	return event.SeriesRule
+}
+
+// originalStart returns the RECURRENCE-ID of an occurrence in loc
+func originalStart(event domain.Event, loc *time.Location) time.Time {
+	original := domain.Event{Start: *event.RecurrenceID, AllDay: event.AllDay, Floating: event.Floating}
+	return original.In(loc).Start
+}
@@ -0,0 +1,163 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+func formatTestEvents(t *testing.T) []domain.Event {
+	t.Helper()
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	if err != nil {
+		t.Skipf("zone data unavailable: %v", err)
+	}
+
+	review := domain.Event{
+		UID:      "review-1",
+		Summary:  "Review, part 1",
+		Start:    time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+		End:      time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC),
+		Location: "Room \"A\"",
+		Calendar: "work",
+	}
+	standup := domain.Event{
+		UID:        "standup-1",
+		Summary:    "Standup",
+		Start:      time.Date(2025, 9, 1, 9, 0, 0, 0, seoul),
+		End:        time.Date(2025, 9, 1, 9, 15, 0, 0, seoul),
+		Recurrence: &domain.Recurrence{Frequency: "DAILY"},
+		Calendar:   "work",
+	}
+	occurrences := domain.ExpandRecurrence(standup, time.Date(2025, 9, 2, 0, 0, 0, 0, seoul), time.Date(2025, 9, 3, 0, 0, 0, 0, seoul))
+	if len(occurrences) != 1 {
+		t.Fatalf("expected 1 occurrence, got %d", len(occurrences))
+	}
+	return []domain.Event{review, occurrences[0]}
+}
+
+func TestNewEventFormatter(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		columns []string
+		wantErr string
+	}{
+		{name: "default text", format: ""},
+		{name: "json", format: FormatJSON},
+		{name: "csv with columns", format: FormatCSV, columns: []string{"uid", "start"}},
+		{name: "ics", format: FormatICS},
+		{name: "unknown format", format: "xml", wantErr: "unknown format 'xml'"},
+		{name: "unknown column", format: FormatTSV, columns: []string{"color"}, wantErr: "unknown column 'color'"},
+		{name: "columns with json", format: FormatJSON, columns: []string{"uid"}, wantErr: "columns can only be selected"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewEventFormatter(tt.format, tt.columns, false, nil, time.UTC)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestJSONEventFormatter(t *testing.T) {
+	var buf bytes.Buffer
+	JSONEventFormatter{Location: time.UTC}.FormatEvents(formatTestEvents(t), &buf)
+
+	var got []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(got))
+	}
+
+	review := got[0]
+	if review["uid"] != "review-1" || review["calendar"] != "work" || review["start"] != "2025-09-01T10:00:00Z" || review["end"] != "2025-09-01T11:00:00Z" {
+		t.Errorf("unexpected event %v", review)
+	}
+	for _, key := range []string{"description", "allDay", "recurrence", "recurrenceId"} {
+		if _, ok := review[key]; !ok {
+			t.Errorf("expected key %q to be present in %v", key, review)
+		}
+	}
+	if review["recurrence"] != nil || review["recurrenceId"] != nil {
+		t.Errorf("expected no recurrence for a single event, got %v", review)
+	}
+
+	standup := got[1]
+	if standup["start"] != "2025-09-02T00:00:00Z" || standup["recurrence"] != "FREQ=DAILY" || standup["recurrenceId"] != "2025-09-02T00:00:00Z" {
+		t.Errorf("unexpected occurrence %v", standup)
+	}
+}
+
+func TestJSONEventFormatter_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	JSONEventFormatter{}.FormatEvents(nil, &buf)
+	if strings.TrimSpace(buf.String()) != "[]" {
+		t.Errorf("expected an empty array, got %q", buf.String())
+	}
+}
+
+func TestDelimitedEventFormatter(t *testing.T) {
+	tests := []struct {
+		name      string
+		separator rune
+		columns   []string
+		expected  string
+	}{
+		{
+			name:      "csv",
+			separator: ',',
+			columns:   []string{"start", "summary", "location", "recurrence"},
+			expected: "start,summary,location,recurrence\n" +
+				"2025-09-01T10:00:00Z,\"Review, part 1\",\"Room \"\"A\"\"\",\n" +
+				"2025-09-02T00:00:00Z,Standup,,FREQ=DAILY\n",
+		},
+		{
+			name:      "tsv",
+			separator: '\t',
+			columns:   []string{"uid", "all_day", "end"},
+			expected: "uid\tall_day\tend\n" +
+				"review-1\tfalse\t2025-09-01T11:00:00Z\n" +
+				"standup-1\tfalse\t2025-09-02T00:15:00Z\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			formatter := DelimitedEventFormatter{Separator: tt.separator, Columns: tt.columns, Location: time.UTC}
+			formatter.FormatEvents(formatTestEvents(t), &buf)
+			if buf.String() != tt.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestICSEventFormatter(t *testing.T) {
+	var buf bytes.Buffer
+	ICSEventFormatter{}.FormatEvents(formatTestEvents(t), &buf)
+	output := strings.ReplaceAll(buf.String(), "\r\n", "\n")
+
+	for _, want := range []string{"UID:review-1\n", "UID:standup-1-20250902T000000Z\n", "DTSTART;TZID=Asia/Seoul:20250902T090000", "BEGIN:VTIMEZONE"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output:\n%s", want, output)
+		}
+	}
+	for _, unwant := range []string{"RRULE", "RECURRENCE-ID"} {
+		if strings.Contains(output, unwant) {
+			t.Errorf("expected occurrences to be standalone events, got %s:\n%s", unwant, output)
+		}
+	}
+}
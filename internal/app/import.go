@@ -35,7 +35,7 @@ func ImportHandler(importer EventImporter, uidGen UIDGenerator, filePath string,
 		}
 
 		if err := importer.CreateEvent(event); err != nil {
-			return fmt.Errorf("failed to import event %s: %v", event.UID, err)
+			return fmt.Errorf("failed to import event %s: %w", event.UID, err)
 		}
 		imported++
 	}
@@ -2,14 +2,35 @@ package app
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/NaMinhyeok/calcli/internal/tui"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
-// InteractiveHandler launches the interactive TUI
-func InteractiveHandler(reader EventReader) error {
-	model := tui.NewModel(reader)
+// InteractiveOptions configure the interactive TUI
+type InteractiveOptions struct {
+	CalendarOptions
+
+	Location  *time.Location // display zone
+	ReadOnly  []string       // calendars whose events cannot be deleted
+	FirstHour int            // working hours of the day view
+	LastHour  int
+}
+
+// InteractiveHandler launches the interactive TUI. Events are deleted
+// through deleter, and journal entries of journals are shown; either may
+// be nil.
+func InteractiveHandler(reader EventReader, journals tui.JournalReader, deleter tui.EventDeleter, options InteractiveOptions) error {
+	model := tui.NewModel(reader, options.Location).WithReadOnly(options.ReadOnly...).
+		WithWorkingHours(options.FirstHour, options.LastHour).WithColors(options.Colors).
+		WithWeekLayout(options.WeekStart, options.Locale, options.ShowWeekNumbers)
+	if journals != nil {
+		model = model.WithJournals(journals)
+	}
+	if deleter != nil {
+		model = model.WithDeleter(deleter)
+	}
 
 	p := tea.NewProgram(model, tea.WithAltScreen())
 
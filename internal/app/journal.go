@@ -0,0 +1,123 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/render"
+	"github.com/NaMinhyeok/calcli/internal/util"
+)
+
+type JournalLister interface {
+	ListJournals() ([]domain.Journal, error)
+}
+
+type JournalCreator interface {
+	CreateJournal(journal domain.Journal) error
+}
+
+// JournalNewOptions describes a journal entry to create
+type JournalNewOptions struct {
+	Title string
+	Text  string
+	Date  string // day the entry belongs to, today when empty
+}
+
+// JournalNewHandler creates a journal entry for a day
+func JournalNewHandler(creator JournalCreator, timeProvider util.TimeProvider, uidGen UIDGenerator, opts JournalNewOptions) error {
+	if opts.Title == "" && opts.Text == "" {
+		return fmt.Errorf("a journal entry needs a title or text")
+	}
+
+	date := startOfDay(timeProvider.Now())
+	if opts.Date != "" {
+		parsed, err := util.ParseDateIn(opts.Date, date.Location())
+		if err != nil {
+			return fmt.Errorf("invalid date format: %v", err)
+		}
+		date = parsed
+	}
+
+	uid, err := uidGen.Generate()
+	if err != nil {
+		return fmt.Errorf("failed to generate UID: %v", err)
+	}
+
+	return creator.CreateJournal(domain.Journal{
+		UID:         uid,
+		Summary:     opts.Title,
+		Description: opts.Text,
+		Start:       date,
+		AllDay:      true,
+	})
+}
+
+// JournalListHandler prints one line per journal entry between from and to
+// (inclusive days in loc, unbounded when nil), oldest first
+func JournalListHandler(lister JournalLister, output io.Writer, from, to *time.Time, loc *time.Location, showUID bool) error {
+	journals, err := lister.ListJournals()
+	if err != nil {
+		return err
+	}
+	domain.SortJournals(journals)
+
+	for _, journal := range journals {
+		day := journal.Date(loc)
+		if from != nil && day.Before(startOfDay(from.In(loc))) {
+			continue
+		}
+		if to != nil && day.After(to.In(loc)) {
+			continue
+		}
+
+		fmt.Fprintf(output, "%s %s", day.Format("2006-01-02"), render.FormatJournalEntry(journal))
+		if showUID {
+			fmt.Fprintf(output, " [%s]", journal.UID)
+		}
+		fmt.Fprintf(output, "\n")
+	}
+	return nil
+}
+
+// JournalShowHandler prints the full text of the journal entries of the day
+// of date in loc
+func JournalShowHandler(lister JournalLister, output io.Writer, date time.Time, loc *time.Location) error {
+	journals, err := lister.ListJournals()
+	if err != nil {
+		return err
+	}
+	domain.SortJournals(journals)
+
+	found := 0
+	for _, journal := range journals {
+		if !journal.OnDate(date, loc) {
+			continue
+		}
+		if found > 0 {
+			fmt.Fprintln(output)
+		}
+		found++
+
+		// Untitled entries are headed by the first line of their text
+		fmt.Fprintln(output, render.FormatJournalEntry(journal))
+		text := journal.Description
+		if journal.Summary == "" {
+			_, text, _ = strings.Cut(text, "\n")
+		}
+		if text != "" {
+			fmt.Fprintln(output, text)
+		}
+	}
+
+	if found == 0 {
+		return fmt.Errorf("no journal entries on %s", date.In(loc).Format("2006-01-02"))
+	}
+	return nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
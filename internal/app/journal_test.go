@@ -0,0 +1,182 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+type FakeJournalStore struct {
+	journals []domain.Journal
+	err      error
+}
+
+func (f *FakeJournalStore) ListJournals() ([]domain.Journal, error) {
+	return f.journals, f.err
+}
+
+func (f *FakeJournalStore) CreateJournal(journal domain.Journal) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.journals = append(f.journals, journal)
+	return nil
+}
+
+func TestJournalNewHandler(t *testing.T) {
+	timeProvider := &StubTimeProvider{FixedTime: time.Date(2025, 9, 10, 15, 30, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name         string
+		opts         JournalNewOptions
+		expectError  bool
+		expectedDate time.Time
+	}{
+		{
+			name:         "today by default",
+			opts:         JournalNewOptions{Title: "Standup notes", Text: "Release moved to Friday."},
+			expectedDate: time.Date(2025, 9, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "given date",
+			opts:         JournalNewOptions{Text: "Quiet day", Date: "2025-09-08"},
+			expectedDate: time.Date(2025, 9, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "empty entry",
+			opts:        JournalNewOptions{Date: "2025-09-08"},
+			expectError: true,
+		},
+		{
+			name:        "invalid date",
+			opts:        JournalNewOptions{Title: "Notes", Date: "someday"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &FakeJournalStore{}
+			err := JournalNewHandler(store, timeProvider, &StubUIDGenerator{uid: "journal-1"}, tt.opts)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(store.journals) != 1 {
+				t.Fatalf("expected 1 entry, got %d", len(store.journals))
+			}
+			journal := store.journals[0]
+			if journal.UID != "journal-1" || journal.Summary != tt.opts.Title || journal.Description != tt.opts.Text {
+				t.Errorf("unexpected entry %+v", journal)
+			}
+			if !journal.AllDay || !journal.Start.Equal(tt.expectedDate) {
+				t.Errorf("expected an entry for %v, got %v (all-day %v)", tt.expectedDate, journal.Start, journal.AllDay)
+			}
+		})
+	}
+}
+
+func TestJournalListHandler(t *testing.T) {
+	store := &FakeJournalStore{journals: []domain.Journal{
+		{UID: "notes-2", Summary: "Retro", Start: time.Date(2025, 9, 12, 16, 0, 0, 0, time.UTC)},
+		{UID: "notes-1", Summary: "Standup notes", Start: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), AllDay: true},
+		{UID: "notes-3", Description: "Quiet day\nNothing planned", Start: time.Date(2025, 9, 20, 0, 0, 0, 0, time.UTC), AllDay: true},
+	}}
+	from := time.Date(2025, 9, 5, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		from     *time.Time
+		to       *time.Time
+		showUID  bool
+		expected string
+	}{
+		{
+			name:     "all entries",
+			expected: "2025-09-01 ¶ Standup notes\n2025-09-12 ¶ Retro\n2025-09-20 ¶ Quiet day\n",
+		},
+		{
+			name:     "range with UIDs",
+			from:     &from,
+			to:       &to,
+			showUID:  true,
+			expected: "2025-09-12 ¶ Retro [notes-2]\n2025-09-20 ¶ Quiet day [notes-3]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var output bytes.Buffer
+			if err := JournalListHandler(store, &output, tt.from, tt.to, time.UTC, tt.showUID); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if output.String() != tt.expected {
+				t.Errorf("expected output:\n%s\ngot:\n%s", tt.expected, output.String())
+			}
+		})
+	}
+}
+
+func TestJournalShowHandler(t *testing.T) {
+	store := &FakeJournalStore{journals: []domain.Journal{
+		{UID: "notes-1", Summary: "Standup notes", Description: "Release moved to Friday.", Start: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), AllDay: true},
+		{UID: "notes-2", Description: "Evening\nWrote the migration plan.", Start: time.Date(2025, 9, 1, 19, 0, 0, 0, time.UTC)},
+	}}
+
+	tests := []struct {
+		name        string
+		date        time.Time
+		listErr     error
+		expectError bool
+		expected    string
+	}{
+		{
+			name:     "entries of the day",
+			date:     time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+			expected: "¶ Standup notes\nRelease moved to Friday.\n\n¶ Evening\nWrote the migration plan.\n",
+		},
+		{
+			name:        "no entries",
+			date:        time.Date(2025, 9, 2, 0, 0, 0, 0, time.UTC),
+			expectError: true,
+		},
+		{
+			name:        "list failure",
+			date:        time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+			listErr:     fmt.Errorf("calendar unavailable"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store.err = tt.listErr
+			var output bytes.Buffer
+
+			err := JournalShowHandler(store, &output, tt.date, time.UTC)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if output.String() != tt.expected {
+				t.Errorf("expected output:\n%s\ngot:\n%s", tt.expected, output.String())
+			}
+		})
+	}
+}
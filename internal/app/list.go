@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/render"
 )
 
 type EventLister interface {
@@ -13,7 +14,7 @@ type EventLister interface {
 }
 
 type EventFormatter interface {
-	FormatEvents([]domain.Event, io.Writer)
+	FormatEvents([]domain.Event, io.Writer) error
 }
 
 type HardcodedEventLister struct{}
@@ -45,16 +46,15 @@ func (h HardcodedEventLister) ListEvents() ([]domain.Event, error) {
 }
 
 type SimpleEventFormatter struct {
-	ShowUID bool
+	ShowUID  bool
+	Colors   map[string]string // colors of calendars by name
+	Location *time.Location    // display zone, event zone when nil
 }
 
-func (s SimpleEventFormatter) FormatEvents(events []domain.Event, w io.Writer) {
+func (s SimpleEventFormatter) FormatEvents(events []domain.Event, w io.Writer) error {
 	for _, event := range events {
-		fmt.Fprintf(w, "%s - %s %s",
-			event.Start.Format("15:04"),
-			event.End.Format("15:04"),
-			event.Summary,
-		)
+		event = event.In(s.Location)
+		fmt.Fprintf(w, "%s %s", formatTimeRange(event), render.Colorize(s.Colors[event.Calendar], event.Summary))
 		if s.ShowUID {
 			fmt.Fprintf(w, " [%s]", event.UID)
 		}
@@ -63,7 +63,28 @@ func (s SimpleEventFormatter) FormatEvents(events []domain.Event, w io.Writer) {
 		if event.Location != "" {
 			fmt.Fprintf(w, "  @ %s\n", event.Location)
 		}
+		if event.Organizer != nil {
+			fmt.Fprintf(w, "  organizer: %s\n", event.Organizer)
+		}
+		if len(event.Attendees) > 0 {
+			fmt.Fprintf(w, "  attendees: %s\n", render.FormatAttendees(event.Attendees))
+		}
+	}
+	return nil
+}
+
+// formatTimeRange renders "15:04 - 16:00" for timed events, "all-day" for
+// single all-day events and includes dates for events spanning several days
+func formatTimeRange(event domain.Event) string {
+	switch {
+	case event.AllDay && event.IsMultiDay():
+		return fmt.Sprintf("%s - %s", event.Start.Format("2006-01-02"), event.LastDate().Format("2006-01-02"))
+	case event.AllDay:
+		return "all-day"
+	case event.IsMultiDay():
+		return fmt.Sprintf("%s - %s", event.Start.Format("2006-01-02 15:04"), event.EndTime().Format("2006-01-02 15:04"))
 	}
+	return fmt.Sprintf("%s - %s", event.Start.Format("15:04"), event.EndTime().Format("15:04"))
 }
 
 func ListHandler(lister EventLister, formatter EventFormatter, w io.Writer, from, to *time.Time) error {
@@ -85,7 +106,7 @@ func ListHandler(lister EventLister, formatter EventFormatter, w io.Writer, from
 	}
 
 	for _, event := range events {
-		if event.Recurrence != nil {
+		if event.IsRecurring() {
 			expandedEvents := domain.ExpandRecurrence(event, rangeStart, rangeEnd)
 			filteredEvents = append(filteredEvents, expandedEvents...)
 		} else {
@@ -95,23 +116,17 @@ func ListHandler(lister EventLister, formatter EventFormatter, w io.Writer, from
 		}
 	}
 
-	formatter.FormatEvents(filteredEvents, w)
-	return nil
+	domain.SortEvents(filteredEvents)
+	return formatter.FormatEvents(filteredEvents, w)
 }
 
 func shouldIncludeEvent(event domain.Event, from, to *time.Time) bool {
-	if from == nil && to == nil {
-		return true
-	}
-
-	eventStart := event.Start
-	eventEnd := event.End
-
-	if from != nil && eventEnd.Before(*from) {
+	// Multi-day events are included on every day they cover
+	if from != nil && event.Start.Before(*from) && !event.EndTime().After(*from) {
 		return false
 	}
 
-	if to != nil && eventStart.After(*to) {
+	if to != nil && event.Start.After(*to) {
 		return false
 	}
 
@@ -7,6 +7,9 @@ import (
 	"time"
 
 	"github.com/NaMinhyeok/calcli/internal/domain"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 )
 
 // FakeEventLister provides configurable test data
@@ -55,6 +58,25 @@ func TestListHandler(t *testing.T) {
 			expectedOutput: "16:00 - 16:30 Quick Call\n",
 			expectError:    false,
 		},
+		{
+			name: "events sorted by start",
+			events: []domain.Event{
+				{
+					UID:     "test-late",
+					Summary: "Late",
+					Start:   time.Date(2025, 8, 28, 9, 0, 0, 0, time.Local),
+					End:     time.Date(2025, 8, 28, 10, 0, 0, 0, time.Local),
+				},
+				{
+					UID:     "test-early",
+					Summary: "Early",
+					Start:   time.Date(2025, 8, 27, 9, 0, 0, 0, time.Local),
+					End:     time.Date(2025, 8, 27, 10, 0, 0, 0, time.Local),
+				},
+			},
+			expectedOutput: "09:00 - 10:00 Early\n09:00 - 10:00 Late\n",
+			expectError:    false,
+		},
 		{
 			name:           "empty events list",
 			events:         []domain.Event{},
@@ -131,6 +153,126 @@ func TestSimpleEventFormatter(t *testing.T) {
 	}
 }
 
+func TestSimpleEventFormatter_Colors(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(profile)
+	lipgloss.SetColorProfile(termenv.ANSI256)
+
+	var buf bytes.Buffer
+	formatter := &SimpleEventFormatter{Colors: map[string]string{"work": "green"}, Location: time.UTC}
+	formatter.FormatEvents([]domain.Event{
+		{Summary: "Review", Calendar: "work", Start: time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC), End: time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC)},
+		{Summary: "Dinner", Calendar: "home", Start: time.Date(2025, 9, 1, 19, 0, 0, 0, time.UTC), End: time.Date(2025, 9, 1, 20, 0, 0, 0, time.UTC)},
+	}, &buf)
+
+	expected := "10:00 - 11:00 \x1b[32mReview\x1b[0m\n19:00 - 20:00 Dinner\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestSimpleEventFormatter_Spans(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    domain.Event
+		expected string
+	}{
+		{
+			name: "all-day event",
+			event: domain.Event{
+				Summary: "Holiday",
+				Start:   time.Date(2025, 10, 3, 0, 0, 0, 0, time.Local),
+				End:     time.Date(2025, 10, 4, 0, 0, 0, 0, time.Local),
+				AllDay:  true,
+			},
+			expected: "all-day Holiday\n",
+		},
+		{
+			name: "multi-day all-day event",
+			event: domain.Event{
+				Summary: "Conference",
+				Start:   time.Date(2025, 9, 1, 0, 0, 0, 0, time.Local),
+				End:     time.Date(2025, 9, 4, 0, 0, 0, 0, time.Local),
+				AllDay:  true,
+			},
+			expected: "2025-09-01 - 2025-09-03 Conference\n",
+		},
+		{
+			name: "overnight event",
+			event: domain.Event{
+				Summary: "Night Shift",
+				Start:   time.Date(2025, 9, 1, 22, 0, 0, 0, time.Local),
+				End:     time.Date(2025, 9, 2, 6, 0, 0, 0, time.Local),
+			},
+			expected: "2025-09-01 22:00 - 2025-09-02 06:00 Night Shift\n",
+		},
+		{
+			name: "event with attendees",
+			event: domain.Event{
+				Summary:   "Review",
+				Start:     time.Date(2025, 9, 1, 14, 0, 0, 0, time.Local),
+				End:       time.Date(2025, 9, 1, 15, 0, 0, 0, time.Local),
+				Organizer: &domain.Attendee{Email: "alice@example.com", Name: "Alice"},
+				Attendees: []domain.Attendee{
+					{Email: "bob@example.com", Name: "Bob", Status: "ACCEPTED"},
+					{Email: "carol@example.com"},
+				},
+			},
+			expected: "14:00 - 15:00 Review\n" +
+				"  organizer: Alice <alice@example.com>\n" +
+				"  attendees: Bob <bob@example.com> (accepted), carol@example.com\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			formatter := &SimpleEventFormatter{}
+
+			formatter.FormatEvents([]domain.Event{tt.event}, &buf)
+
+			if buf.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestListHandler_IncludesSpanningEvents(t *testing.T) {
+	events := []domain.Event{
+		{
+			UID:     "conference",
+			Summary: "Conference",
+			Start:   time.Date(2025, 9, 1, 0, 0, 0, 0, time.Local),
+			End:     time.Date(2025, 9, 4, 0, 0, 0, 0, time.Local),
+			AllDay:  true,
+		},
+		{
+			UID:     "holiday",
+			Summary: "Holiday",
+			Start:   time.Date(2025, 8, 31, 0, 0, 0, 0, time.Local),
+			End:     time.Date(2025, 9, 1, 0, 0, 0, 0, time.Local),
+			AllDay:  true,
+		},
+	}
+
+	var buf bytes.Buffer
+	from := time.Date(2025, 9, 2, 0, 0, 0, 0, time.Local)
+	to := time.Date(2025, 9, 2, 23, 59, 59, 0, time.Local)
+
+	if err := ListHandler(FakeEventLister{events: events}, &SimpleEventFormatter{}, &buf, &from, &to); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, "Conference") {
+		t.Errorf("expected conference covering the range, got %q", output)
+	}
+	if strings.Contains(output, "Holiday") {
+		t.Errorf("expected holiday ending before the range to be excluded, got %q", output)
+	}
+}
+
 func TestListHandlerWithDateRange(t *testing.T) {
 	events := []domain.Event{
 		{
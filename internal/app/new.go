@@ -3,6 +3,9 @@ package app
 import (
 	"crypto/rand"
 	"fmt"
+	"net/mail"
+	"strings"
+	"time"
 
 	"github.com/NaMinhyeok/calcli/internal/domain"
 	"github.com/NaMinhyeok/calcli/internal/util"
@@ -51,32 +54,91 @@ func NewHandler(creator EventCreator, timeProvider util.TimeProvider, uidGen UID
 }
 
 func NewHandlerWithRecurrence(creator EventCreator, timeProvider util.TimeProvider, uidGen UIDGenerator, title, when, duration, location, repeat string, count int, until string) error {
-	startTime, err := util.ParseTime(when, timeProvider)
-	if err != nil {
-		return fmt.Errorf("invalid time format: %v", err)
+	return NewHandlerWithOptions(creator, timeProvider, uidGen, NewOptions{
+		Title:    title,
+		When:     when,
+		Duration: duration,
+		Location: location,
+		Repeat:   repeat,
+		Count:    count,
+		Until:    until,
+	})
+}
+
+// NewOptions describes an event to create
+type NewOptions struct {
+	Title     string
+	When      string
+	Duration  string
+	Location  string
+	Repeat    string
+	Count     int
+	Until     string
+	AllDay    bool
+	Days      int      // length of an all-day event in days, implies AllDay
+	Attendees []string // "bob@example.com" or "Bob <bob@example.com>"
+	Organizer string   // organizer of the attendees, given like one of them
+	Alarms    []string // how long before the start to remind, e.g. "15m"
+}
+
+func NewHandlerWithOptions(creator EventCreator, timeProvider util.TimeProvider, uidGen UIDGenerator, opts NewOptions) error {
+	event := domain.Event{
+		Summary:  opts.Title,
+		Location: opts.Location,
 	}
 
-	dur, err := util.ParseDuration(duration)
-	if err != nil {
-		return fmt.Errorf("invalid duration: %v", err)
+	if err := addAttendees(&event, opts.Attendees, opts.Organizer); err != nil {
+		return err
+	}
+
+	for _, value := range opts.Alarms {
+		before, err := time.ParseDuration(value)
+		if err != nil || before < 0 {
+			return fmt.Errorf("invalid alarm '%s': expected a time before the start such as 15m or 1h", value)
+		}
+		event.Alarms = append(event.Alarms, domain.Alarm{Action: "DISPLAY", Description: opts.Title, Trigger: -before})
+	}
+
+	if opts.AllDay || opts.Days > 0 {
+		days := opts.Days
+		if days == 0 {
+			days = 1
+		}
+		if days < 0 {
+			return fmt.Errorf("invalid number of days: %d", days)
+		}
+
+		date, err := parseAllDayDate(opts.When, timeProvider)
+		if err != nil {
+			return fmt.Errorf("invalid date format: %v", err)
+		}
+
+		event.AllDay = true
+		event.Start = date
+		event.End = date.AddDate(0, 0, days)
+	} else {
+		startTime, err := util.ParseTime(opts.When, timeProvider)
+		if err != nil {
+			return fmt.Errorf("invalid time format: %v", err)
+		}
+
+		dur, err := util.ParseDuration(opts.Duration)
+		if err != nil {
+			return fmt.Errorf("invalid duration: %v", err)
+		}
+
+		event.Start = startTime
+		event.End = startTime.Add(dur)
 	}
 
 	uid, err := uidGen.Generate()
 	if err != nil {
 		return fmt.Errorf("failed to generate UID: %v", err)
 	}
+	event.UID = uid
 
-	event := domain.Event{
-		UID:      uid,
-		Summary:  title,
-		Start:    startTime,
-		End:      startTime.Add(dur),
-		Location: location,
-		AllDay:   false,
-	}
-
-	if repeat != "" {
-		recurrence, err := parseRecurrenceOptions(repeat, count, until)
+	if opts.Repeat != "" {
+		recurrence, err := parseRecurrenceOptions(opts.Repeat, opts.Count, opts.Until, event.Start.Location())
 		if err != nil {
 			return fmt.Errorf("invalid recurrence options: %v", err)
 		}
@@ -86,7 +148,63 @@ func NewHandlerWithRecurrence(creator EventCreator, timeProvider util.TimeProvid
 	return creator.CreateEvent(event)
 }
 
-func parseRecurrenceOptions(repeat string, count int, until string) (*domain.Recurrence, error) {
+// parseAllDayDate accepts a date ("2025-09-01", "tomorrow", "+3d") or any
+// start time accepted by util.ParseTime, returning midnight of that day
+func parseAllDayDate(when string, timeProvider util.TimeProvider) (time.Time, error) {
+	loc := timeProvider.Now().Location()
+	if t, err := util.ParseTime(when, timeProvider); err == nil {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc), nil
+	}
+	return util.ParseDateIn(when, loc)
+}
+
+// addAttendees invites the attendees given as "bob@example.com" or
+// "Bob <bob@example.com>". They are required participants asked to reply.
+// RFC 5546 requires an organizer on events with attendees, so organizer,
+// given the same way, becomes the organizer of events that have none.
+func addAttendees(event *domain.Event, values []string, organizer string) error {
+	if len(values) > 0 && event.Organizer == nil {
+		if organizer == "" {
+			return fmt.Errorf("inviting attendees needs an organizer: use --organizer or set defaults.organizer")
+		}
+		address, err := mail.ParseAddress(organizer)
+		if err != nil {
+			return fmt.Errorf("invalid organizer '%s': expected an email address or \"Name <email>\"", organizer)
+		}
+		event.Organizer = &domain.Attendee{Email: address.Address, Name: address.Name}
+	}
+
+	for _, value := range values {
+		address, err := mail.ParseAddress(value)
+		if err != nil {
+			return fmt.Errorf("invalid attendee '%s': expected an email address or \"Name <email>\"", value)
+		}
+		if findAttendee(event.Attendees, address.Address) >= 0 {
+			return fmt.Errorf("%s is already an attendee", address.Address)
+		}
+
+		event.Attendees = append(event.Attendees, domain.Attendee{
+			Email:  address.Address,
+			Name:   address.Name,
+			Role:   "REQ-PARTICIPANT",
+			Status: "NEEDS-ACTION",
+			RSVP:   true,
+		})
+	}
+	return nil
+}
+
+// findAttendee returns the index of the attendee with email, -1 when absent
+func findAttendee(attendees []domain.Attendee, email string) int {
+	for i, attendee := range attendees {
+		if strings.EqualFold(attendee.Email, email) {
+			return i
+		}
+	}
+	return -1
+}
+
+func parseRecurrenceOptions(repeat string, count int, until string, loc *time.Location) (*domain.Recurrence, error) {
 	var frequency string
 	switch repeat {
 	case "daily":
@@ -115,7 +233,7 @@ func parseRecurrenceOptions(repeat string, count int, until string) (*domain.Rec
 	}
 
 	if until != "" {
-		untilTime, err := util.ParseDate(until)
+		untilTime, err := util.ParseDateIn(until, loc)
 		if err != nil {
 			return nil, fmt.Errorf("invalid until date: %v", err)
 		}
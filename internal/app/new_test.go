@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -367,3 +368,200 @@ func TestNewHandlerWithRecurrence(t *testing.T) {
 		})
 	}
 }
+
+func TestNewHandlerWithOptions_AllDay(t *testing.T) {
+	fixedTime := time.Date(2025, 8, 29, 10, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name          string
+		options       NewOptions
+		expectErr     bool
+		expectedStart time.Time
+		expectedEnd   time.Time
+	}{
+		{
+			name:          "all-day on a date",
+			options:       NewOptions{Title: "Holiday", When: "2025-10-03", AllDay: true},
+			expectedStart: time.Date(2025, 10, 3, 0, 0, 0, 0, time.Local),
+			expectedEnd:   time.Date(2025, 10, 4, 0, 0, 0, 0, time.Local),
+		},
+		{
+			name:          "all-day today from a time",
+			options:       NewOptions{Title: "Focus Day", When: "09:00", AllDay: true},
+			expectedStart: time.Date(2025, 8, 29, 0, 0, 0, 0, time.Local),
+			expectedEnd:   time.Date(2025, 8, 30, 0, 0, 0, 0, time.Local),
+		},
+		{
+			name:          "days implies all-day",
+			options:       NewOptions{Title: "Conference", When: "2025-09-30", Days: 3},
+			expectedStart: time.Date(2025, 9, 30, 0, 0, 0, 0, time.Local),
+			expectedEnd:   time.Date(2025, 10, 3, 0, 0, 0, 0, time.Local),
+		},
+		{
+			name:      "negative days",
+			options:   NewOptions{Title: "Bad", When: "2025-09-30", AllDay: true, Days: -1},
+			expectErr: true,
+		},
+		{
+			name:      "invalid date",
+			options:   NewOptions{Title: "Bad", When: "someday", AllDay: true},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creator := &FakeEventCreator{}
+			timeProvider := &StubTimeProvider{FixedTime: fixedTime}
+			uidGen := &StubUIDGenerator{uid: "all-day-uid"}
+
+			err := NewHandlerWithOptions(creator, timeProvider, uidGen, tt.options)
+
+			if tt.expectErr {
+				if err == nil {
+					t.Error("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			event := creator.events[0]
+			if !event.AllDay {
+				t.Error("expected AllDay to be true")
+			}
+			if !event.Start.Equal(tt.expectedStart) {
+				t.Errorf("expected start %v, got %v", tt.expectedStart, event.Start)
+			}
+			if !event.End.Equal(tt.expectedEnd) {
+				t.Errorf("expected end %v, got %v", tt.expectedEnd, event.End)
+			}
+		})
+	}
+}
+
+func TestNewHandlerWithOptions_Attendees(t *testing.T) {
+	tests := []struct {
+		name      string
+		attendees []string
+		organizer string
+		expected  []domain.Attendee
+		expectErr bool
+	}{
+		{
+			name:      "address and named attendee",
+			attendees: []string{"bob@example.com", "Carol Smith <carol@example.com>"},
+			organizer: "Alice <alice@example.com>",
+			expected: []domain.Attendee{
+				{Email: "bob@example.com", Role: "REQ-PARTICIPANT", Status: "NEEDS-ACTION", RSVP: true},
+				{Email: "carol@example.com", Name: "Carol Smith", Role: "REQ-PARTICIPANT", Status: "NEEDS-ACTION", RSVP: true},
+			},
+		},
+		{
+			name:      "invalid address",
+			attendees: []string{"bob"},
+			organizer: "alice@example.com",
+			expectErr: true,
+		},
+		{
+			name:      "duplicate attendee",
+			attendees: []string{"bob@example.com", "Bob <BOB@example.com>"},
+			organizer: "alice@example.com",
+			expectErr: true,
+		},
+		{
+			name:      "no organizer",
+			attendees: []string{"bob@example.com"},
+			expectErr: true,
+		},
+		{
+			name:      "invalid organizer",
+			attendees: []string{"bob@example.com"},
+			organizer: "alice",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creator := &FakeEventCreator{}
+			timeProvider := &StubTimeProvider{FixedTime: time.Date(2025, 8, 29, 10, 0, 0, 0, time.Local)}
+			uidGen := &StubUIDGenerator{uid: "meeting-uid"}
+			options := NewOptions{Title: "Review", When: "14:00", Duration: "1h", Attendees: tt.attendees, Organizer: tt.organizer}
+
+			err := NewHandlerWithOptions(creator, timeProvider, uidGen, options)
+
+			if tt.expectErr {
+				if err == nil {
+					t.Error("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if !slices.Equal(creator.events[0].Attendees, tt.expected) {
+				t.Errorf("expected attendees %+v, got %+v", tt.expected, creator.events[0].Attendees)
+			}
+			organizer := domain.Attendee{Email: "alice@example.com", Name: "Alice"}
+			if creator.events[0].Organizer == nil || *creator.events[0].Organizer != organizer {
+				t.Errorf("expected organizer %+v, got %+v", organizer, creator.events[0].Organizer)
+			}
+		})
+	}
+}
+
+func TestNewHandlerWithOptions_Alarms(t *testing.T) {
+	tests := []struct {
+		name      string
+		alarms    []string
+		expected  []domain.Alarm
+		expectErr bool
+	}{
+		{
+			name:   "two reminders",
+			alarms: []string{"15m", "1h"},
+			expected: []domain.Alarm{
+				{Action: "DISPLAY", Description: "Review", Trigger: -15 * time.Minute},
+				{Action: "DISPLAY", Description: "Review", Trigger: -time.Hour},
+			},
+		},
+		{
+			name:      "invalid duration",
+			alarms:    []string{"soon"},
+			expectErr: true,
+		},
+		{
+			name:      "negative duration",
+			alarms:    []string{"-5m"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creator := &FakeEventCreator{}
+			timeProvider := &StubTimeProvider{FixedTime: time.Date(2025, 8, 29, 10, 0, 0, 0, time.Local)}
+			uidGen := &StubUIDGenerator{uid: "alarm-uid"}
+			options := NewOptions{Title: "Review", When: "14:00", Duration: "1h", Alarms: tt.alarms}
+
+			err := NewHandlerWithOptions(creator, timeProvider, uidGen, options)
+
+			if tt.expectErr {
+				if err == nil {
+					t.Error("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if !slices.EqualFunc(creator.events[0].Alarms, tt.expected, domain.Alarm.Equal) {
+				t.Errorf("expected alarms %+v, got %+v", tt.expected, creator.events[0].Alarms)
+			}
+		})
+	}
+}
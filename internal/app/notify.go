@@ -0,0 +1,109 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// StdoutNotifier prints reminders, ringing the terminal bell for AUDIO
+// alarms
+type StdoutNotifier struct {
+	Output   io.Writer
+	Location *time.Location // display zone, event zone when nil
+}
+
+func (n StdoutNotifier) Notify(reminder Reminder) error {
+	bell := ""
+	if reminder.Alarm.Action == "AUDIO" {
+		bell = "\a"
+	}
+	_, err := fmt.Fprintf(n.Output, "%s[%s] %s: %s\n", bell, reminder.At.In(n.location()).Format("15:04"), reminder.Event.Summary, reminderBody(reminder, n.Location))
+	return err
+}
+
+func (n StdoutNotifier) location() *time.Location {
+	if n.Location == nil {
+		return time.Local
+	}
+	return n.Location
+}
+
+// CommandNotifier runs a shell command for every reminder. The reminder is
+// passed in CALCLI_* environment variables.
+type CommandNotifier struct {
+	Command  string
+	Location *time.Location // zone of CALCLI_MESSAGE, event zone when nil
+}
+
+func (n CommandNotifier) Notify(reminder Reminder) error {
+	event := reminder.Event
+	cmd := exec.Command("sh", "-c", n.Command)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(),
+		"CALCLI_UID="+event.UID,
+		"CALCLI_SUMMARY="+event.Summary,
+		"CALCLI_LOCATION="+event.Location,
+		"CALCLI_START="+event.Start.Format(time.RFC3339),
+		"CALCLI_END="+event.EndTime().Format(time.RFC3339),
+		"CALCLI_ACTION="+reminder.Alarm.Action,
+		"CALCLI_MESSAGE="+reminderBody(reminder, n.Location),
+	)
+	return cmd.Run()
+}
+
+// DesktopNotifier shows reminders as desktop notifications through
+// notify-send, or osascript on macOS
+type DesktopNotifier struct {
+	Location *time.Location // display zone, event zone when nil
+	program  string
+}
+
+// NewDesktopNotifier finds the program used to show notifications
+func NewDesktopNotifier(loc *time.Location) (*DesktopNotifier, error) {
+	program := "notify-send"
+	if runtime.GOOS == "darwin" {
+		program = "osascript"
+	}
+	if _, err := exec.LookPath(program); err != nil {
+		return nil, fmt.Errorf("desktop notifications need %s: %v", program, err)
+	}
+	return &DesktopNotifier{Location: loc, program: program}, nil
+}
+
+func (n *DesktopNotifier) Notify(reminder Reminder) error {
+	title, body := reminder.Event.Summary, reminderBody(reminder, n.Location)
+	if n.program == "osascript" {
+		script := fmt.Sprintf("display notification %s with title %s", appleScriptString(body), appleScriptString(title))
+		if reminder.Alarm.Action == "AUDIO" {
+			script += ` sound name "default"`
+		}
+		return exec.Command("osascript", "-e", script).Run()
+	}
+	// "--" keeps titles starting with "-" from being read as options
+	return exec.Command(n.program, "--app-name=calcli", "--", title, body).Run()
+}
+
+// reminderBody describes the occurrence of a reminder, e.g.
+// "14:00 - 15:00 @ Room 1", followed by the alarm's own description
+func reminderBody(reminder Reminder, loc *time.Location) string {
+	event := reminder.Event.In(loc)
+	body := formatTimeRange(event)
+	if event.Location != "" {
+		body += " @ " + event.Location
+	}
+	if desc := reminder.Alarm.Description; desc != "" && desc != event.Summary {
+		body += " - " + desc
+	}
+	return body
+}
+
+// appleScriptString quotes s as an AppleScript string literal
+func appleScriptString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
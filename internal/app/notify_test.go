@@ -0,0 +1,107 @@
+package app
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+func testReminder(action, description string) Reminder {
+	event := domain.Event{
+		UID:      "meeting-1",
+		Summary:  "Review",
+		Start:    time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+		End:      time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC),
+		Location: "Room 1",
+	}
+	alarm := domain.Alarm{Action: action, Description: description, Trigger: -15 * time.Minute}
+	return Reminder{Event: event, Alarm: alarm, At: alarm.TriggerTime(event)}
+}
+
+func TestStdoutNotifier(t *testing.T) {
+	tests := []struct {
+		name     string
+		reminder Reminder
+		expected string
+	}{
+		{
+			name:     "display alarm",
+			reminder: testReminder("DISPLAY", "Review"),
+			expected: "[09:45] Review: 10:00 - 11:00 @ Room 1\n",
+		},
+		{
+			name:     "audio alarm with its own description",
+			reminder: testReminder("AUDIO", "Bring slides"),
+			expected: "\a[09:45] Review: 10:00 - 11:00 @ Room 1 - Bring slides\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var output bytes.Buffer
+			notifier := StdoutNotifier{Output: &output, Location: time.UTC}
+
+			if err := notifier.Notify(tt.reminder); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if output.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, output.String())
+			}
+		})
+	}
+}
+
+func TestCommandNotifier(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out")
+	notifier := CommandNotifier{
+		Command:  `printf '%s|%s|%s' "$CALCLI_SUMMARY" "$CALCLI_START" "$CALCLI_MESSAGE" > "$OUT"`,
+		Location: time.UTC,
+	}
+	t.Setenv("OUT", out)
+
+	if err := notifier.Notify(testReminder("DISPLAY", "Review")); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	content, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("failed to read command output: %v", err)
+	}
+	if expected := "Review|2025-09-01T10:00:00Z|10:00 - 11:00 @ Room 1"; string(content) != expected {
+		t.Errorf("expected %q, got %q", expected, content)
+	}
+
+	if err := (CommandNotifier{Command: "exit 3"}).Notify(testReminder("DISPLAY", "")); err == nil {
+		t.Error("expected error from failing command")
+	}
+}
+
+func TestDesktopNotifier(t *testing.T) {
+	dir := t.TempDir()
+	out := filepath.Join(dir, "out")
+	program := filepath.Join(dir, "notify-send")
+	script := "#!/bin/sh\nprintf '%s|' \"$@\" > \"$OUT\"\n"
+	if err := os.WriteFile(program, []byte(script), 0755); err != nil {
+		t.Fatalf("failed to write fake notify-send: %v", err)
+	}
+	t.Setenv("OUT", out)
+
+	reminder := testReminder("DISPLAY", "")
+	reminder.Event.Summary = "-1 day: release"
+	notifier := &DesktopNotifier{Location: time.UTC, program: program}
+	if err := notifier.Notify(reminder); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	content, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("failed to read notify-send arguments: %v", err)
+	}
+	if expected := "--app-name=calcli|--|-1 day: release|10:00 - 11:00 @ Room 1|"; string(content) != expected {
+		t.Errorf("expected arguments %q, got %q", expected, content)
+	}
+}
@@ -7,8 +7,9 @@ import (
 	"github.com/NaMinhyeok/calcli/internal/storage/cache"
 )
 
-// ReindexHandler clears the cache, forcing all events to be reloaded
-func ReindexHandler(eventCache *cache.EventCache, output io.Writer) error {
+// ReindexHandler clears the cache and rebuilds it by reading every event
+// through reader, which must be backed by eventCache
+func ReindexHandler(eventCache *cache.EventCache, reader EventReader, output io.Writer) error {
 	if eventCache == nil {
 		return fmt.Errorf("cache is not enabled")
 	}
@@ -17,7 +18,12 @@ func ReindexHandler(eventCache *cache.EventCache, output io.Writer) error {
 	eventCache.Clear()
 
 	fmt.Fprintf(output, "Cache cleared. Removed %d cached events.\n", oldSize)
-	fmt.Fprintf(output, "Next read will rebuild the cache.\n")
 
+	events, err := reader.ListEvents()
+	if err != nil {
+		return fmt.Errorf("failed to rebuild index: %w", err)
+	}
+
+	fmt.Fprintf(output, "Index rebuilt with %d events.\n", len(events))
 	return nil
 }
@@ -2,6 +2,7 @@ package app
 
 import (
 	"bytes"
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -24,8 +25,10 @@ func TestReindexHandler(t *testing.T) {
 			t.Fatalf("Expected cache size 2, got %d", eventCache.Size())
 		}
 
+		reader := FakeEventLister{events: []domain.Event{event1, event2, {UID: "event3"}}}
+
 		var buf bytes.Buffer
-		err := ReindexHandler(eventCache, &buf)
+		err := ReindexHandler(eventCache, reader, &buf)
 		if err != nil {
 			t.Fatalf("Unexpected error: %v", err)
 		}
@@ -38,14 +41,14 @@ func TestReindexHandler(t *testing.T) {
 		if !strings.Contains(output, "Removed 2 cached events") {
 			t.Errorf("Expected output to mention 2 events, got: %s", output)
 		}
-		if !strings.Contains(output, "Next read will rebuild") {
+		if !strings.Contains(output, "Index rebuilt with 3 events") {
 			t.Errorf("Expected output to mention rebuild, got: %s", output)
 		}
 	})
 
 	t.Run("nil cache returns error", func(t *testing.T) {
 		var buf bytes.Buffer
-		err := ReindexHandler(nil, &buf)
+		err := ReindexHandler(nil, FakeEventLister{}, &buf)
 		if err == nil {
 			t.Error("Expected error for nil cache, got nil")
 		}
@@ -58,7 +61,7 @@ func TestReindexHandler(t *testing.T) {
 		eventCache := cache.NewEventCache(100, true)
 
 		var buf bytes.Buffer
-		err := ReindexHandler(eventCache, &buf)
+		err := ReindexHandler(eventCache, FakeEventLister{}, &buf)
 		if err != nil {
 			t.Fatalf("Unexpected error: %v", err)
 		}
@@ -68,4 +71,14 @@ func TestReindexHandler(t *testing.T) {
 			t.Errorf("Expected output to mention 0 events, got: %s", output)
 		}
 	})
+
+	t.Run("read error", func(t *testing.T) {
+		eventCache := cache.NewEventCache(100, true)
+
+		var buf bytes.Buffer
+		err := ReindexHandler(eventCache, FakeEventLister{err: errors.New("permission denied")}, &buf)
+		if err == nil || !strings.Contains(err.Error(), "permission denied") {
+			t.Errorf("Expected read error, got: %v", err)
+		}
+	})
 }
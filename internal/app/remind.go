@@ -0,0 +1,248 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/util"
+)
+
+// Reminder is an alarm of one occurrence that is due
+type Reminder struct {
+	Event domain.Event // the occurrence the alarm belongs to
+	Alarm domain.Alarm
+	At    time.Time // when the alarm triggers
+}
+
+// key identifies the reminder in the state file. Absolute alarms fire once
+// for the whole series, relative ones once per occurrence.
+func (r Reminder) key() string {
+	if r.Alarm.At != nil {
+		return fmt.Sprintf("%s|%s", r.Event.UID, r.At.UTC().Format(time.RFC3339))
+	}
+	return fmt.Sprintf("%s|%s|%s", r.Event.UID, r.Event.OriginalStart().UTC().Format(time.RFC3339), r.At.UTC().Format(time.RFC3339))
+}
+
+// Notifier delivers reminders
+type Notifier interface {
+	Notify(reminder Reminder) error
+}
+
+type RemindOptions struct {
+	Interval time.Duration // how often the calendars are checked
+	CatchUp  time.Duration // how late a missed reminder may still fire
+	Once     bool          // check once and return
+}
+
+// RemindHandler fires the reminders of the events of lister through
+// notifier until ctx is cancelled. Reminders missed by at most
+// options.CatchUp, e.g. while the computer was asleep, still fire; state
+// keeps them from firing twice, also across restarts. Failures while
+// running are reported on output and retried at the next check.
+func RemindHandler(ctx context.Context, lister EventLister, notifier Notifier, state *ReminderState, timeProvider util.TimeProvider, output io.Writer, options RemindOptions) error {
+	if options.Once {
+		return checkReminders(lister, notifier, state, timeProvider.Now(), options.CatchUp)
+	}
+
+	ticker := time.NewTicker(options.Interval)
+	defer ticker.Stop()
+	for {
+		if err := checkReminders(lister, notifier, state, timeProvider.Now(), options.CatchUp); err != nil {
+			fmt.Fprintf(output, "Warning: %v\n", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkReminders fires the reminders due at now that have not fired yet
+func checkReminders(lister EventLister, notifier Notifier, state *ReminderState, now time.Time, catchUp time.Duration) error {
+	events, err := lister.ListEvents()
+	if err != nil {
+		return fmt.Errorf("failed to load events: %w", err)
+	}
+
+	from := now.Add(-catchUp)
+	var notifyErr error
+	for _, reminder := range DueReminders(events, from, now) {
+		if state.hasFired(reminder) {
+			continue
+		}
+		if err := notifier.Notify(reminder); err != nil {
+			notifyErr = fmt.Errorf("failed to send reminder for '%s': %w", reminder.Event.Summary, err)
+			continue
+		}
+		state.markFired(reminder)
+	}
+
+	state.prune(from)
+	if err := state.Save(); err != nil {
+		return err
+	}
+	return notifyErr
+}
+
+// DueReminders returns the reminders of events triggering in (from, to],
+// ordered by trigger time. Recurring events are expanded.
+func DueReminders(events []domain.Event, from, to time.Time) []Reminder {
+	var reminders []Reminder
+	seen := make(map[string]bool)
+	add := func(event domain.Event, alarm domain.Alarm) {
+		reminder := Reminder{Event: event, Alarm: alarm, At: alarm.TriggerTime(event)}
+		if reminder.At.After(from) && !reminder.At.After(to) && !seen[reminder.key()] {
+			seen[reminder.key()] = true
+			reminders = append(reminders, reminder)
+		}
+	}
+
+	for _, event := range events {
+		// Absolute alarms fire at their own time, whatever the occurrences
+		for _, component := range append([]domain.Event{event}, event.Overrides...) {
+			for _, alarm := range component.Alarms {
+				if alarm.At != nil {
+					add(component, alarm)
+				}
+			}
+		}
+
+		reach, ok := alarmReach(event)
+		if !ok {
+			continue
+		}
+		for _, instance := range domain.ExpandRecurrence(event, from.Add(-reach), to.Add(reach)) {
+			for _, alarm := range instance.Alarms {
+				if alarm.At == nil {
+					add(instance, alarm)
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(reminders, func(i, j int) bool {
+		return reminders[i].At.Before(reminders[j].At)
+	})
+	return reminders
+}
+
+// alarmReach returns how far from the start of an occurrence its relative
+// alarms may trigger, reporting false when there are none
+func alarmReach(event domain.Event) (time.Duration, bool) {
+	var reach time.Duration
+	found := false
+	for _, component := range append([]domain.Event{event}, event.Overrides...) {
+		for _, alarm := range component.Alarms {
+			if alarm.At != nil {
+				continue
+			}
+			distance := alarm.Trigger
+			if distance < 0 {
+				distance = -distance
+			}
+			if alarm.RelatedEnd {
+				distance += component.Duration()
+			}
+			reach = max(reach, distance)
+			found = true
+		}
+	}
+	return reach, found
+}
+
+// ReminderState records the reminders already fired, so a restarted
+// 'calcli remind' doesn't repeat them
+type ReminderState struct {
+	Fired map[string]time.Time `json:"fired"` // reminder key to trigger time
+
+	path    string
+	changed bool
+}
+
+// LoadReminderState reads the state file at path. A missing file gives an
+// empty state.
+func LoadReminderState(path string) (*ReminderState, error) {
+	state := &ReminderState{Fired: make(map[string]time.Time), path: path}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reminder state: %w", err)
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to decode reminder state %s: %w", path, err)
+	}
+	if state.Fired == nil {
+		state.Fired = make(map[string]time.Time)
+	}
+	return state, nil
+}
+
+func (s *ReminderState) hasFired(reminder Reminder) bool {
+	_, ok := s.Fired[reminder.key()]
+	return ok
+}
+
+func (s *ReminderState) markFired(reminder Reminder) {
+	s.Fired[reminder.key()] = reminder.At
+	s.changed = true
+}
+
+// prune forgets reminders triggering before from, which can't be due again
+func (s *ReminderState) prune(from time.Time) {
+	for key, at := range s.Fired {
+		if !at.After(from) {
+			delete(s.Fired, key)
+			s.changed = true
+		}
+	}
+}
+
+// Save atomically writes the state file when it changed since it was read
+func (s *ReminderState) Save() error {
+	if !s.changed {
+		return nil
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("failed to encode reminder state: %w", err)
+	}
+
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	tmpFile, err := os.CreateTemp(dir, "remind-state_*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("failed to write reminder state: %w", err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary file: %w", err)
+	}
+	if err := os.Rename(tmpFile.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to replace reminder state %s: %w", s.path, err)
+	}
+
+	s.changed = false
+	return nil
+}
@@ -0,0 +1,189 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+type FakeNotifier struct {
+	reminders []Reminder
+	err       error
+}
+
+func (f *FakeNotifier) Notify(reminder Reminder) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.reminders = append(f.reminders, reminder)
+	return nil
+}
+
+func TestDueReminders(t *testing.T) {
+	before15m := domain.Alarm{Action: "DISPLAY", Trigger: -15 * time.Minute}
+	at := time.Date(2025, 9, 1, 8, 30, 0, 0, time.UTC)
+	moved := time.Date(2025, 9, 2, 9, 0, 0, 0, time.UTC)
+
+	single := domain.Event{
+		UID:     "single-1",
+		Summary: "Dentist",
+		Start:   time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+		End:     time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC),
+		Alarms:  []domain.Alarm{before15m, {Action: "AUDIO", Trigger: 0, RelatedEnd: true}},
+	}
+	absolute := domain.Event{
+		UID:     "absolute-1",
+		Summary: "Flight",
+		Start:   time.Date(2025, 9, 5, 10, 0, 0, 0, time.UTC),
+		End:     time.Date(2025, 9, 5, 12, 0, 0, 0, time.UTC),
+		Alarms:  []domain.Alarm{{Action: "DISPLAY", At: &at}},
+	}
+	series := domain.Event{
+		UID:        "series-1",
+		Summary:    "Standup",
+		Start:      time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC),
+		End:        time.Date(2025, 9, 1, 9, 15, 0, 0, time.UTC),
+		Recurrence: &domain.Recurrence{Frequency: "DAILY", Interval: 1},
+		Alarms:     []domain.Alarm{before15m},
+		Overrides: []domain.Event{
+			{
+				UID:          "series-1",
+				Summary:      "Standup (late)",
+				Start:        time.Date(2025, 9, 2, 11, 0, 0, 0, time.UTC),
+				End:          time.Date(2025, 9, 2, 11, 15, 0, 0, time.UTC),
+				RecurrenceID: &moved,
+				Alarms:       []domain.Alarm{before15m},
+			},
+		},
+	}
+	noAlarms := domain.Event{
+		UID:   "quiet-1",
+		Start: time.Date(2025, 9, 1, 8, 50, 0, 0, time.UTC),
+		End:   time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC),
+	}
+	events := []domain.Event{single, absolute, series, noAlarms}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		expected []string // summary and trigger time
+	}{
+		{
+			name: "morning of the first day",
+			from: time.Date(2025, 9, 1, 8, 0, 0, 0, time.UTC),
+			to:   time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+			expected: []string{
+				"Flight 08:30",
+				"Standup 08:45",
+				"Dentist 09:45",
+			},
+		},
+		{
+			name:     "end of the dentist appointment",
+			from:     time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+			to:       time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC),
+			expected: []string{"Dentist 11:00"},
+		},
+		{
+			name:     "moved occurrence",
+			from:     time.Date(2025, 9, 2, 8, 0, 0, 0, time.UTC),
+			to:       time.Date(2025, 9, 2, 12, 0, 0, 0, time.UTC),
+			expected: []string{"Standup (late) 10:45"},
+		},
+		{
+			name: "range start is exclusive",
+			from: time.Date(2025, 9, 1, 8, 45, 0, 0, time.UTC),
+			to:   time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminders := DueReminders(events, tt.from, tt.to)
+
+			var got []string
+			for _, reminder := range reminders {
+				got = append(got, fmt.Sprintf("%s %s", reminder.Event.Summary, reminder.At.UTC().Format("15:04")))
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.expected) {
+				t.Errorf("expected reminders %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRemindHandler_Once(t *testing.T) {
+	lister := FakeEventLister{events: []domain.Event{
+		{
+			UID:     "meeting-1",
+			Summary: "Review",
+			Start:   time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+			End:     time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC),
+			Alarms:  []domain.Alarm{{Action: "DISPLAY", Trigger: -15 * time.Minute}},
+		},
+	}}
+	statePath := filepath.Join(t.TempDir(), "remind-state.json")
+	options := RemindOptions{CatchUp: 10 * time.Minute, Once: true}
+
+	tests := []struct {
+		name        string
+		now         time.Time
+		notifierErr error
+		expectFired int
+	}{
+		{name: "not due yet", now: time.Date(2025, 9, 1, 9, 40, 0, 0, time.UTC)},
+		{name: "notifier failure", now: time.Date(2025, 9, 1, 9, 46, 0, 0, time.UTC), notifierErr: fmt.Errorf("no display")},
+		{name: "due after failed attempt", now: time.Date(2025, 9, 1, 9, 47, 0, 0, time.UTC), expectFired: 1},
+		{name: "already fired before restart", now: time.Date(2025, 9, 1, 9, 48, 0, 0, time.UTC)},
+		{name: "missed by more than catch-up", now: time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)},
+	}
+
+	// Each check loads the state again, like a restarted daemon
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, err := LoadReminderState(statePath)
+			if err != nil {
+				t.Fatalf("failed to load state: %v", err)
+			}
+			notifier := &FakeNotifier{err: tt.notifierErr}
+			timeProvider := &StubTimeProvider{FixedTime: tt.now}
+			var output bytes.Buffer
+
+			err = RemindHandler(context.Background(), lister, notifier, state, timeProvider, &output, options)
+
+			if (err != nil) != (tt.notifierErr != nil) {
+				t.Fatalf("expected error %v, got %v", tt.notifierErr, err)
+			}
+			if len(notifier.reminders) != tt.expectFired {
+				t.Errorf("expected %d reminders, got %d", tt.expectFired, len(notifier.reminders))
+			}
+		})
+	}
+}
+
+func TestRemindHandler_StopsWhenCancelled(t *testing.T) {
+	lister := FakeEventLister{err: fmt.Errorf("calendar unavailable")}
+	state, err := LoadReminderState(filepath.Join(t.TempDir(), "remind-state.json"))
+	if err != nil {
+		t.Fatalf("failed to load state: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var output bytes.Buffer
+	timeProvider := &StubTimeProvider{FixedTime: time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)}
+	err = RemindHandler(ctx, lister, &FakeNotifier{}, state, timeProvider, &output, RemindOptions{Interval: time.Hour})
+
+	if err != nil {
+		t.Errorf("expected no error after cancellation, got %v", err)
+	}
+	if expected := "Warning: failed to load events: calendar unavailable\n"; output.String() != expected {
+		t.Errorf("expected output %q, got %q", expected, output.String())
+	}
+}
@@ -33,7 +33,7 @@ func SearchHandler(searcher EventSearcher, formatter EventFormatter, output io.W
 
 	var expandedEvents []domain.Event
 	for _, event := range events {
-		if event.Recurrence != nil {
+		if event.IsRecurring() {
 			instances := domain.ExpandRecurrence(event, rangeStart, rangeEnd)
 			expandedEvents = append(expandedEvents, instances...)
 		} else {
@@ -48,8 +48,8 @@ func SearchHandler(searcher EventSearcher, formatter EventFormatter, output io.W
 		}
 	}
 
-	formatter.FormatEvents(matches, output)
-	return nil
+	domain.SortEvents(matches)
+	return formatter.FormatEvents(matches, output)
 }
 
 func matchesEvent(event domain.Event, query string, field SearchField) bool {
@@ -0,0 +1,219 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/ical"
+	"github.com/NaMinhyeok/calcli/internal/render"
+	"github.com/NaMinhyeok/calcli/internal/util"
+)
+
+// EventLocator finds the file an event is stored in
+type EventLocator interface {
+	EventPath(uid string) (string, error)
+}
+
+type ShowOptions struct {
+	Occurrences int  // upcoming occurrences listed for recurring events
+	Raw         bool // print the iCalendar source of the event
+}
+
+// ShowHandler prints every detail of the event whose UID is or starts with
+// uid. locator may be nil, leaving out the file path.
+func ShowHandler(lister EventLister, locator EventLocator, timeProvider util.TimeProvider, output io.Writer, uid string, options ShowOptions) error {
+	event, err := findEventByPrefix(lister, uid)
+	if err != nil {
+		return err
+	}
+
+	now := timeProvider.Now()
+	loc := now.Location()
+	shown := event.In(loc)
+
+	// fields prints a field with its values one per line, skipping empty ones
+	fields := func(name string, values ...string) {
+		label := name + ":"
+		for _, value := range values {
+			if value == "" {
+				continue
+			}
+			fmt.Fprintf(output, "%-12s %s\n", label, value)
+			label = ""
+		}
+	}
+
+	fields("Title", event.Summary)
+	fields("UID", event.UID)
+	fields("Calendar", event.Calendar)
+	if locator != nil {
+		if path, err := locator.EventPath(event.UID); err == nil {
+			fields("File", path)
+		}
+	}
+	fields("When", formatWhen(shown))
+	fields("Location", event.Location)
+	fields("Categories", strings.Join(event.Categories, ", "))
+
+	if event.Recurrence != nil {
+		fields("Repeats", fmt.Sprintf("%s (%s)", render.DescribeRecurrence(event.Recurrence), ical.FormatRRULE(event.Recurrence, event)))
+	}
+	fields("Also on", formatDates(event.RDates, event, loc))
+	fields("Except", formatDates(event.ExDates, event, loc))
+	if len(event.Overrides) > 0 {
+		fields("Changed", fmt.Sprintf("%d occurrence(s)", len(event.Overrides)))
+	}
+
+	if event.Organizer != nil {
+		fields("Organizer", event.Organizer.String())
+	}
+	attendees := make([]string, len(event.Attendees))
+	for i, attendee := range event.Attendees {
+		attendees[i] = render.FormatAttendee(attendee)
+	}
+	fields("Attendees", attendees...)
+	alarms := make([]string, len(event.Alarms))
+	for i, alarm := range event.Alarms {
+		alarms[i] = formatAlarm(alarm, loc)
+	}
+	fields("Alarms", alarms...)
+
+	if event.Description != "" {
+		fmt.Fprintln(output, "Description:")
+		for _, line := range strings.Split(event.Description, "\n") {
+			fmt.Fprintf(output, "  %s\n", line)
+		}
+	}
+
+	if event.IsRecurring() && options.Occurrences > 0 {
+		next := nextOccurrences(event, now, options.Occurrences)
+		if len(next) > 0 {
+			fmt.Fprintln(output, "Next:")
+			for _, occurrence := range next {
+				fmt.Fprintf(output, "  %s\n", formatWhen(occurrence.In(loc)))
+			}
+		}
+	}
+
+	if options.Raw && event.Source != "" {
+		fmt.Fprintln(output, "Source:")
+		for _, source := range append([]string{event.Source}, overrideSources(event)...) {
+			fmt.Fprint(output, strings.ReplaceAll(source, "\r\n", "\n"))
+		}
+	}
+	return nil
+}
+
+// findEventByPrefix returns the event with uid, or the only event whose UID
+// starts with uid
+func findEventByPrefix(lister EventLister, uid string) (domain.Event, error) {
+	if len(uid) < 3 {
+		return domain.Event{}, fmt.Errorf("UID '%s' is too short. UIDs should be at least 3 characters long", uid)
+	}
+
+	events, err := lister.ListEvents()
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	var matches []domain.Event
+	for _, event := range events {
+		if event.UID == uid {
+			return event, nil
+		}
+		if strings.HasPrefix(event.UID, uid) {
+			matches = append(matches, event)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return domain.Event{}, fmt.Errorf("no event found with UID '%s'. Use 'calcli list --show-uid' or 'calcli search --show-uid <query>' to find valid UIDs", uid)
+	case 1:
+		return matches[0], nil
+	}
+
+	uids := make([]string, len(matches))
+	for i, match := range matches {
+		uids[i] = match.UID
+	}
+	sort.Strings(uids)
+	return domain.Event{}, fmt.Errorf("UID '%s' is ambiguous, it matches %s", uid, strings.Join(uids, ", "))
+}
+
+// formatWhen renders when an event takes place, e.g.
+// "Mon 2025-09-01 10:00 - 11:00" or "Fri 2025-09-05 - Sun 2025-09-07 (all-day)"
+func formatWhen(event domain.Event) string {
+	switch {
+	case event.AllDay && event.IsMultiDay():
+		return event.Start.Format("Mon 2006-01-02") + " - " + event.LastDate().Format("Mon 2006-01-02") + " (all-day)"
+	case event.AllDay:
+		return event.Start.Format("Mon 2006-01-02") + " (all-day)"
+	case event.IsMultiDay():
+		return event.Start.Format("Mon 2006-01-02 15:04") + " - " + event.EndTime().Format("Mon 2006-01-02 15:04")
+	}
+	return event.Start.Format("Mon 2006-01-02 15:04") + " - " + event.EndTime().Format("15:04")
+}
+
+// formatDates renders RDATE or EXDATE values in the way the event is timed
+func formatDates(dates []time.Time, event domain.Event, loc *time.Location) string {
+	formatted := make([]string, len(dates))
+	for i, date := range dates {
+		shown := domain.Event{Start: date, AllDay: event.AllDay, Floating: event.Floating}.In(loc)
+		if event.AllDay {
+			formatted[i] = shown.Start.Format("2006-01-02")
+		} else {
+			formatted[i] = shown.Start.Format("2006-01-02 15:04")
+		}
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// formatAlarm describes when an alarm fires, e.g. "15m before start"
+func formatAlarm(alarm domain.Alarm, loc *time.Location) string {
+	if alarm.At != nil {
+		return "at " + alarm.At.In(loc).Format("2006-01-02 15:04")
+	}
+
+	anchor := "start"
+	if alarm.RelatedEnd {
+		anchor = "end"
+	}
+	switch {
+	case alarm.Trigger < 0:
+		return render.FormatDuration(-alarm.Trigger) + " before " + anchor
+	case alarm.Trigger > 0:
+		return render.FormatDuration(alarm.Trigger) + " after " + anchor
+	}
+	return "at " + anchor
+}
+
+// nextOccurrences returns up to n occurrences of a recurring event that
+// have not ended at now
+func nextOccurrences(event domain.Event, now time.Time, n int) []domain.Event {
+	var next []domain.Event
+	for _, occurrence := range domain.ExpandRecurrence(event, now, now.AddDate(10, 0, 0)) {
+		if len(next) == n {
+			break
+		}
+		if occurrence.EndTime().After(now) {
+			next = append(next, occurrence)
+		}
+	}
+	return next
+}
+
+// overrideSources returns the sources of the overrides of a series
+func overrideSources(event domain.Event) []string {
+	var sources []string
+	for _, override := range event.Overrides {
+		if override.Source != "" {
+			sources = append(sources, override.Source)
+		}
+	}
+	return sources
+}
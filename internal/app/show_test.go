@@ -0,0 +1,146 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+// FakeEventLocator maps UIDs to file paths
+type FakeEventLocator map[string]string
+
+func (f FakeEventLocator) EventPath(uid string) (string, error) {
+	if path, ok := f[uid]; ok {
+		return path, nil
+	}
+	return "", errors.New("not found")
+}
+
+func TestShowHandler(t *testing.T) {
+	count := 10
+	standup := domain.Event{
+		UID:         "standup-1234",
+		Summary:     "Standup",
+		Description: "Daily sync\nBring updates",
+		Start:       time.Date(2025, 9, 2, 9, 0, 0, 0, time.UTC),
+		End:         time.Date(2025, 9, 2, 9, 15, 0, 0, time.UTC),
+		Location:    "Room A",
+		Categories:  []string{"work", "team"},
+		Calendar:    "work",
+		Recurrence: &domain.Recurrence{
+			Frequency: "WEEKLY",
+			Interval:  2,
+			Count:     &count,
+			ByDay:     []domain.WeekdayNum{{Weekday: time.Tuesday}},
+		},
+		ExDates:   []time.Time{time.Date(2025, 9, 16, 9, 0, 0, 0, time.UTC)},
+		Organizer: &domain.Attendee{Email: "alice@example.com", Name: "Alice"},
+		Attendees: []domain.Attendee{
+			{Email: "bob@example.com", Status: "ACCEPTED"},
+			{Email: "carol@example.com"},
+		},
+		Alarms: []domain.Alarm{{Action: "DISPLAY", Trigger: -15 * time.Minute}, {Action: "DISPLAY", Trigger: 90 * time.Minute, RelatedEnd: true}},
+		Source: "BEGIN:VEVENT\r\nUID:standup-1234\r\nX-CUSTOM:kept\r\nEND:VEVENT\r\n",
+	}
+	trip := domain.Event{
+		UID:      "standby-5678",
+		Summary:  "Trip",
+		Start:    time.Date(2025, 9, 5, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2025, 9, 8, 0, 0, 0, 0, time.UTC),
+		AllDay:   true,
+		Calendar: "home",
+	}
+	lister := FakeEventLister{events: []domain.Event{standup, trip}}
+	locator := FakeEventLocator{"standup-1234": "/cal/work/standup-1234.ics"}
+	timeProvider := &StubTimeProvider{FixedTime: time.Date(2025, 9, 10, 12, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name        string
+		uid         string
+		options     ShowOptions
+		wantLines   []string
+		unwantLines []string
+		wantErr     string
+	}{
+		{
+			name:    "recurring event by prefix",
+			uid:     "standup",
+			options: ShowOptions{Occurrences: 2},
+			wantLines: []string{
+				"Title:       Standup",
+				"UID:         standup-1234",
+				"Calendar:    work",
+				"File:        /cal/work/standup-1234.ics",
+				"When:        Tue 2025-09-02 09:00 - 09:15",
+				"Location:    Room A",
+				"Categories:  work, team",
+				"Repeats:     every 2 weeks on Tue, 10 times (FREQ=WEEKLY;INTERVAL=2;COUNT=10;BYDAY=TU)",
+				"Except:      2025-09-16 09:00",
+				"Organizer:   Alice <alice@example.com>",
+				"Attendees:   bob@example.com (accepted)\n             carol@example.com",
+				"Alarms:      15m before start\n             1h30m after end",
+				"Description:\n  Daily sync\n  Bring updates",
+				"Next:\n  Tue 2025-09-30 09:00 - 09:15\n  Tue 2025-10-14 09:00 - 09:15\n",
+			},
+			unwantLines: []string{"Source:", "X-CUSTOM"},
+		},
+		{
+			name:      "raw source",
+			uid:       "standup-1234",
+			options:   ShowOptions{Raw: true},
+			wantLines: []string{"Source:\nBEGIN:VEVENT\nUID:standup-1234\nX-CUSTOM:kept\nEND:VEVENT\n"},
+		},
+		{
+			name:        "all-day event",
+			uid:         "standby",
+			wantLines:   []string{"When:        Fri 2025-09-05 - Sun 2025-09-07 (all-day)", "Calendar:    home"},
+			unwantLines: []string{"File:", "Repeats:", "Next:"},
+		},
+		{
+			name:    "ambiguous prefix",
+			uid:     "stand",
+			wantErr: "UID 'stand' is ambiguous, it matches standby-5678, standup-1234",
+		},
+		{
+			name:    "unknown UID",
+			uid:     "missing",
+			wantErr: "no event found with UID 'missing'",
+		},
+		{
+			name:    "short UID",
+			uid:     "st",
+			wantErr: "too short",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := ShowHandler(lister, locator, timeProvider, &buf, tt.uid, tt.options)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, want := range tt.wantLines {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("expected %q in output:\n%s", want, buf.String())
+				}
+			}
+			for _, unwant := range tt.unwantLines {
+				if strings.Contains(buf.String(), unwant) {
+					t.Errorf("did not expect %q in output:\n%s", unwant, buf.String())
+				}
+			}
+		})
+	}
+}
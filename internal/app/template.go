@@ -0,0 +1,200 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/ical"
+	"github.com/NaMinhyeok/calcli/internal/render"
+)
+
+// TemplatePlaceholders are the {placeholders} an event template can use.
+// Times take a Go layout after a colon, e.g. {start:Mon 02 Jan 15:04}.
+var TemplatePlaceholders = []string{
+	"title", "description", "location", "calendar", "uid", "recurrence",
+	"start", "start-date", "start-time", "end", "end-date", "end-time",
+	"calendar-color", "reset",
+}
+
+// timePlaceholders are the placeholders rendering a time and their layouts
+var timePlaceholders = map[string]string{
+	"start":      "2006-01-02 15:04",
+	"start-date": "2006-01-02",
+	"start-time": "15:04",
+	"end":        "2006-01-02 15:04",
+	"end-date":   "2006-01-02",
+	"end-time":   "15:04",
+}
+
+var placeholderPattern = regexp.MustCompile(`\{([a-z-]+)(?::([^{}]*))?\}`)
+
+// TemplateEvent is the data an event template is executed with
+type TemplateEvent struct {
+	domain.Event
+	Color string // escape sequence of the calendar color, "" without colors
+}
+
+// Placeholder renders the {name} or {name:layout} placeholder of the event.
+// Start and end times are empty for all-day events.
+func (e TemplateEvent) Placeholder(name, layout string) string {
+	if def, ok := timePlaceholders[name]; ok {
+		return e.formatTime(name, layout, def)
+	}
+
+	switch name {
+	case "title":
+		return e.Summary
+	case "description":
+		return e.Description
+	case "location":
+		return e.Location
+	case "calendar":
+		return e.Calendar
+	case "uid":
+		return e.UID
+	case "recurrence":
+		return ical.FormatRRULE(seriesRule(e.Event), e.Event)
+	case "calendar-color":
+		return e.Color
+	case "reset":
+		if e.Color == "" {
+			return ""
+		}
+		return render.ResetSequence
+	}
+	return ""
+}
+
+func (e TemplateEvent) formatTime(name, layout, def string) string {
+	if e.AllDay && strings.HasSuffix(name, "-time") {
+		return ""
+	}
+
+	t := e.Start
+	if strings.HasPrefix(name, "end") {
+		t = e.EndTime()
+		if e.AllDay {
+			t = e.LastDate()
+		}
+	}
+
+	if layout == "" {
+		layout = def
+		if e.AllDay {
+			layout = "2006-01-02"
+		}
+	}
+	return t.Format(layout)
+}
+
+// TemplateEventFormatter writes each event as a line rendered from a
+// template. Templates use khal-style {placeholders} and may also contain
+// text/template actions on a TemplateEvent.
+type TemplateEventFormatter struct {
+	template *template.Template
+	colors   map[string]string // calendar name to color
+	location *time.Location
+}
+
+// NewTemplateEventFormatter parses text into a formatter displaying times
+// in loc. colors maps calendar names to their configured colors.
+func NewTemplateEventFormatter(text string, colors map[string]string, loc *time.Location) (*TemplateEventFormatter, error) {
+	translated, err := translatePlaceholders(text)
+	if err != nil {
+		return nil, err
+	}
+
+	funcs := template.FuncMap{
+		"date": func(layout string, t time.Time) string { return t.Format(layout) },
+	}
+	tmpl, err := template.New("event").Funcs(funcs).Parse(translated)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %v", err)
+	}
+	// Actions naming unknown fields only fail when executed
+	if err := tmpl.Execute(io.Discard, TemplateEvent{}); err != nil {
+		return nil, fmt.Errorf("invalid template: %v", err)
+	}
+
+	return &TemplateEventFormatter{template: tmpl, colors: colors, location: loc}, nil
+}
+
+// translatePlaceholders rewrites the {placeholders} of text into template
+// actions, leaving {{actions}} untouched
+func translatePlaceholders(text string) (string, error) {
+	var b strings.Builder
+	for len(text) > 0 {
+		if strings.HasPrefix(text, "{{") {
+			end := strings.Index(text, "}}")
+			if end < 0 {
+				return "", fmt.Errorf("invalid template: unclosed action")
+			}
+			b.WriteString(text[:end+2])
+			text = text[end+2:]
+			continue
+		}
+
+		loc := placeholderPattern.FindStringSubmatchIndex(text)
+		if loc == nil || loc[0] != 0 {
+			next := len(text)
+			if loc != nil {
+				next = loc[0]
+			}
+			if i := strings.Index(text, "{{"); i >= 0 && i < next {
+				next = i
+			}
+			b.WriteString(text[:next])
+			text = text[next:]
+			continue
+		}
+
+		name := text[loc[2]:loc[3]]
+		var layout string
+		if loc[4] >= 0 {
+			layout = text[loc[4]:loc[5]]
+		}
+		if err := validatePlaceholder(name, loc[4] >= 0); err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&b, "{{.Placeholder %q %q}}", name, layout)
+		text = text[loc[1]:]
+	}
+	return b.String(), nil
+}
+
+func validatePlaceholder(name string, hasLayout bool) error {
+	if _, ok := timePlaceholders[name]; ok {
+		return nil
+	}
+	if !slices.Contains(TemplatePlaceholders, name) {
+		return fmt.Errorf("unknown placeholder {%s}. Valid placeholders: %s", name, strings.Join(TemplatePlaceholders, ", "))
+	}
+	if hasLayout {
+		return fmt.Errorf("placeholder {%s} does not take a format", name)
+	}
+	return nil
+}
+
+// FormatEvents writes a line per event. A template failing on an event
+// stops the output before its line, with an error naming the event.
+func (f *TemplateEventFormatter) FormatEvents(events []domain.Event, w io.Writer) error {
+	for _, event := range events {
+		data := TemplateEvent{
+			Event: event.In(f.location),
+			Color: render.ColorSequence(f.colors[event.Calendar]),
+		}
+		var line bytes.Buffer
+		if err := f.template.Execute(&line, data); err != nil {
+			return fmt.Errorf("failed to format event %s: %w", event.UID, err)
+		}
+		fmt.Fprintf(w, "%s\n", line.String())
+	}
+	return nil
+}
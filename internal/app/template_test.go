@@ -0,0 +1,150 @@
+package app
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestTemplateEventFormatter(t *testing.T) {
+	timed := domain.Event{
+		UID:      "review-1",
+		Summary:  "Review",
+		Start:    time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+		End:      time.Date(2025, 9, 1, 11, 30, 0, 0, time.UTC),
+		Location: "Room A",
+		Calendar: "work",
+	}
+	trip := domain.Event{
+		UID:      "trip-1",
+		Summary:  "Trip",
+		Start:    time.Date(2025, 9, 5, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2025, 9, 8, 0, 0, 0, 0, time.UTC),
+		AllDay:   true,
+		Calendar: "home",
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		events   []domain.Event
+		expected string
+	}{
+		{
+			name:     "khal-style placeholders",
+			template: "{start-date} {start-time}-{end-time} {title} [{calendar}] @ {location}",
+			events:   []domain.Event{timed},
+			expected: "2025-09-01 10:00-11:30 Review [work] @ Room A\n",
+		},
+		{
+			name:     "time layouts",
+			template: "{start:Mon 02 Jan 3:04PM} until {end:15h04}",
+			events:   []domain.Event{timed},
+			expected: "Mon 01 Sep 10:00AM until 11h30\n",
+		},
+		{
+			name:     "all-day events have no times",
+			template: "{start-date}|{start-time}|{end-date}|{end}",
+			events:   []domain.Event{trip},
+			expected: "2025-09-05||2025-09-07|2025-09-07\n",
+		},
+		{
+			name:     "template actions",
+			template: "{{if .AllDay}}all-day{{else}}{{date \"15:04\" .Start}}{{end}} {title}",
+			events:   []domain.Event{timed, trip},
+			expected: "10:00 Review\nall-day Trip\n",
+		},
+		{
+			name:     "braces that are no placeholders",
+			template: "{title} {not a placeholder}",
+			events:   []domain.Event{timed},
+			expected: "Review {not a placeholder}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			formatter, err := NewTemplateEventFormatter(tt.template, nil, time.UTC)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var buf bytes.Buffer
+			formatter.FormatEvents(tt.events, &buf)
+			if buf.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestTemplateEventFormatter_Colors(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(profile)
+	lipgloss.SetColorProfile(termenv.ANSI256)
+
+	formatter, err := NewTemplateEventFormatter("{calendar-color}{title}{reset}", map[string]string{"work": "red"}, time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	formatter.FormatEvents([]domain.Event{
+		{Summary: "Review", Calendar: "work", Start: time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)},
+		{Summary: "Dinner", Calendar: "home", Start: time.Date(2025, 9, 1, 19, 0, 0, 0, time.UTC)},
+	}, &buf)
+
+	expected := "\x1b[31mReview\x1b[0m\nDinner\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestTemplateEventFormatter_ExecutionError(t *testing.T) {
+	formatter, err := NewTemplateEventFormatter("{title}{{if .Attendees}} with {{(index .Attendees 1).Email}}{{end}}", nil, time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	start := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	var buf bytes.Buffer
+	err = formatter.FormatEvents([]domain.Event{
+		{UID: "solo-1", Summary: "Focus", Start: start},
+		{UID: "review-1", Summary: "Review", Start: start, Attendees: []domain.Attendee{{Email: "bob@example.com"}}},
+	}, &buf)
+
+	if err == nil || !strings.Contains(err.Error(), "review-1") {
+		t.Errorf("expected an error naming the event, got %v", err)
+	}
+	if buf.String() != "Focus\n" {
+		t.Errorf("expected output to stop before the failing event, got %q", buf.String())
+	}
+}
+
+func TestNewTemplateEventFormatter_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  string
+	}{
+		{name: "unknown placeholder", template: "{titel}", wantErr: "unknown placeholder {titel}"},
+		{name: "layout on text placeholder", template: "{title:15:04}", wantErr: "does not take a format"},
+		{name: "unclosed action", template: "{{if .AllDay}", wantErr: "unclosed action"},
+		{name: "unknown field", template: "{{.Colour}}", wantErr: "invalid template"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTemplateEventFormatter(tt.template, nil, time.UTC)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/util"
+)
+
+type TodoLister interface {
+	ListTodos() ([]domain.Todo, error)
+}
+
+type TodoCreator interface {
+	CreateTodo(todo domain.Todo) error
+}
+
+type TodoEditor interface {
+	FindTodoByUID(uid string) (domain.Todo, error)
+	UpdateTodo(todo domain.Todo) error
+}
+
+type TodoDeleter interface {
+	TodoEditor
+	DeleteTodo(uid string) error
+}
+
+type TodoListOptions struct {
+	All     bool // include completed and cancelled todos
+	ShowUID bool
+}
+
+// TodoListHandler prints the open todos, or all of them with options.All,
+// ordered by due date and priority
+func TodoListHandler(lister TodoLister, timeProvider util.TimeProvider, output io.Writer, options TodoListOptions) error {
+	todos, err := lister.ListTodos()
+	if err != nil {
+		return err
+	}
+
+	var shown []domain.Todo
+	for _, todo := range todos {
+		if options.All || !todo.IsDone() {
+			shown = append(shown, todo)
+		}
+	}
+	domain.SortTodos(shown)
+
+	now := timeProvider.Now()
+	for _, todo := range shown {
+		fmt.Fprintf(output, "%s %s", todoCheckbox(todo), todo.Summary)
+		if details := todoDetails(todo, now); len(details) > 0 {
+			fmt.Fprintf(output, " (%s)", strings.Join(details, ", "))
+		}
+		if options.ShowUID {
+			fmt.Fprintf(output, " [%s]", todo.UID)
+		}
+		fmt.Fprintf(output, "\n")
+	}
+	return nil
+}
+
+// todoCheckbox renders the status of a todo: "[ ]" open, "[x]" completed
+// and "[-]" cancelled
+func todoCheckbox(todo domain.Todo) string {
+	switch todo.Status {
+	case domain.TodoCompleted:
+		return "[x]"
+	case domain.TodoCancelled:
+		return "[-]"
+	}
+	return "[ ]"
+}
+
+// todoDetails lists the due date, priority and progress of a todo
+func todoDetails(todo domain.Todo, now time.Time) []string {
+	var details []string
+	if todo.Due != nil {
+		details = append(details, "due "+formatTodoTime(*todo.Due, todo, now.Location()))
+		if todo.IsOverdue(now) {
+			details = append(details, "overdue")
+		}
+	}
+	if todo.Priority > 0 {
+		details = append(details, fmt.Sprintf("priority %d", todo.Priority))
+	}
+	if todo.PercentComplete > 0 && !todo.IsDone() {
+		details = append(details, fmt.Sprintf("%d%%", todo.PercentComplete))
+	}
+	if todo.Recurrence != nil {
+		details = append(details, "repeats "+strings.ToLower(todo.Recurrence.Frequency))
+	}
+	return details
+}
+
+// formatTodoTime renders a due or start time of todo, a plain date when the
+// todo is all-day
+func formatTodoTime(t time.Time, todo domain.Todo, loc *time.Location) string {
+	if todo.AllDay {
+		return t.Format("2006-01-02")
+	}
+	if !todo.Floating {
+		t = t.In(loc)
+	}
+	return t.Format("2006-01-02 15:04")
+}
+
+// TodoNewOptions describes a todo to create
+type TodoNewOptions struct {
+	Title       string
+	Description string
+	Due         string // date for an all-day todo or a time, optional
+	Priority    int    // 1 (highest) to 9 (lowest), 0 for none
+	Repeat      string
+	Count       int
+	Until       string
+}
+
+func TodoNewHandler(creator TodoCreator, timeProvider util.TimeProvider, uidGen UIDGenerator, opts TodoNewOptions) error {
+	if err := validatePriority(opts.Priority); err != nil {
+		return err
+	}
+
+	todo := domain.Todo{
+		Summary:     opts.Title,
+		Description: opts.Description,
+		Priority:    opts.Priority,
+		Status:      domain.TodoNeedsAction,
+	}
+
+	if opts.Due != "" {
+		if err := setDue(&todo, opts.Due, timeProvider); err != nil {
+			return err
+		}
+	}
+
+	if opts.Repeat != "" {
+		if todo.Due == nil {
+			return fmt.Errorf("a repeating todo needs a due date")
+		}
+		recurrence, err := parseRecurrenceOptions(opts.Repeat, opts.Count, opts.Until, todo.Due.Location())
+		if err != nil {
+			return fmt.Errorf("invalid recurrence options: %v", err)
+		}
+		todo.Recurrence = recurrence
+	}
+
+	uid, err := uidGen.Generate()
+	if err != nil {
+		return fmt.Errorf("failed to generate UID: %v", err)
+	}
+	todo.UID = uid
+
+	return creator.CreateTodo(todo)
+}
+
+// setDue sets the due date of todo from a date, making it all-day, or a
+// time accepted by util.ParseTime. "none" removes the due date.
+func setDue(todo *domain.Todo, value string, timeProvider util.TimeProvider) error {
+	if value == "none" {
+		todo.Due = nil
+		return nil
+	}
+
+	// DTSTART and DUE share their value type, so the start follows the due
+	// date between DATE and DATE-TIME
+	todo.Floating = false
+	if due, err := util.ParseTime(value, timeProvider); err == nil {
+		todo.Due = &due
+		todo.AllDay = false
+		return nil
+	}
+
+	due, err := util.ParseDateIn(value, timeProvider.Now().Location())
+	if err != nil {
+		return fmt.Errorf("invalid due date '%s': expected a date such as 2025-09-01 or tomorrow, or a time such as '2025-09-01 17:00'", value)
+	}
+	todo.Due = &due
+	todo.AllDay = true
+	if todo.Start != nil {
+		start := time.Date(todo.Start.Year(), todo.Start.Month(), todo.Start.Day(), 0, 0, 0, 0, due.Location())
+		todo.Start = &start
+	}
+	return nil
+}
+
+func validatePriority(priority int) error {
+	if priority < 0 || priority > 9 {
+		return fmt.Errorf("invalid priority %d: expected 1 (highest) to 9 (lowest), or 0 for none", priority)
+	}
+	return nil
+}
+
+// findTodo looks up the todo with uid, pointing at 'todo list --show-uid'
+// when there is none
+func findTodo(editor TodoEditor, uid string) (domain.Todo, error) {
+	if len(uid) < 3 {
+		return domain.Todo{}, fmt.Errorf("UID '%s' is too short. UIDs should be at least 3 characters long", uid)
+	}
+
+	todo, err := editor.FindTodoByUID(uid)
+	if err != nil {
+		return domain.Todo{}, fmt.Errorf("no todo found with UID '%s'. Use 'calcli todo list --all --show-uid' to find valid UIDs", uid)
+	}
+	return todo, nil
+}
+
+// TodoDoneHandler completes a todo. A recurring todo moves on to its next
+// due date instead.
+func TodoDoneHandler(editor TodoEditor, timeProvider util.TimeProvider, output io.Writer, uid string) error {
+	todo, err := findTodo(editor, uid)
+	if err != nil {
+		return err
+	}
+	if todo.IsDone() {
+		return fmt.Errorf("todo '%s' is already %s", todo.Summary, strings.ToLower(todo.Status))
+	}
+
+	now := timeProvider.Now()
+	done := todo.Complete(now)
+	if err := editor.UpdateTodo(done); err != nil {
+		return fmt.Errorf("failed to update todo: %w", err)
+	}
+
+	if !done.IsDone() && done.Due != nil {
+		fmt.Fprintf(output, "Todo '%s' done, next due %s\n", done.Summary, formatTodoTime(*done.Due, done, now.Location()))
+		return nil
+	}
+	fmt.Fprintf(output, "Todo '%s' completed\n", done.Summary)
+	return nil
+}
+
+type TodoEditOptions struct {
+	Title       *string
+	Description *string
+	Due         *string // "none" removes the due date
+	Priority    *int
+	Reopen      bool // mark a completed or cancelled todo as open again
+}
+
+func TodoEditHandler(editor TodoEditor, timeProvider util.TimeProvider, uid string, options TodoEditOptions) error {
+	todo, err := findTodo(editor, uid)
+	if err != nil {
+		return err
+	}
+
+	if options.Title != nil {
+		todo.Summary = *options.Title
+	}
+
+	if options.Description != nil {
+		todo.Description = *options.Description
+	}
+
+	if options.Due != nil {
+		if err := setDue(&todo, *options.Due, timeProvider); err != nil {
+			return err
+		}
+		if todo.Due == nil && todo.Start == nil && todo.Recurrence != nil {
+			return fmt.Errorf("cannot remove the due date of a repeating todo")
+		}
+	}
+
+	if options.Priority != nil {
+		if err := validatePriority(*options.Priority); err != nil {
+			return err
+		}
+		todo.Priority = *options.Priority
+	}
+
+	if options.Reopen {
+		todo.Status = domain.TodoNeedsAction
+		todo.PercentComplete = 0
+		todo.Completed = nil
+	}
+
+	if err := editor.UpdateTodo(todo); err != nil {
+		return fmt.Errorf("failed to update todo: %w", err)
+	}
+	return nil
+}
+
+// TodoDeleteHandler deletes a todo, asking for confirmation on input first
+// unless yes is set
+func TodoDeleteHandler(deleter TodoDeleter, input io.Reader, output io.Writer, uid string, yes bool) error {
+	todo, err := findTodo(deleter, uid)
+	if err != nil {
+		return err
+	}
+
+	if !yes && !confirm(input, output, fmt.Sprintf("Delete todo '%s'?", todo.Summary)) {
+		fmt.Fprintln(output, "Cancelled.")
+		return nil
+	}
+
+	if err := deleter.DeleteTodo(uid); err != nil {
+		return fmt.Errorf("failed to delete todo: %w", err)
+	}
+
+	fmt.Fprintf(output, "Todo '%s' deleted\n", todo.Summary)
+	return nil
+}
@@ -0,0 +1,392 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+type FakeTodoStore struct {
+	todos map[string]domain.Todo
+	order []string
+	err   error
+}
+
+func NewFakeTodoStore(todos ...domain.Todo) *FakeTodoStore {
+	f := &FakeTodoStore{todos: make(map[string]domain.Todo)}
+	for _, todo := range todos {
+		f.CreateTodo(todo)
+	}
+	return f
+}
+
+func (f *FakeTodoStore) ListTodos() ([]domain.Todo, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	var todos []domain.Todo
+	for _, uid := range f.order {
+		if todo, ok := f.todos[uid]; ok {
+			todos = append(todos, todo)
+		}
+	}
+	return todos, nil
+}
+
+func (f *FakeTodoStore) CreateTodo(todo domain.Todo) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.todos[todo.UID] = todo
+	f.order = append(f.order, todo.UID)
+	return nil
+}
+
+func (f *FakeTodoStore) FindTodoByUID(uid string) (domain.Todo, error) {
+	todo, ok := f.todos[uid]
+	if !ok {
+		return domain.Todo{}, fmt.Errorf("todo with UID %s not found", uid)
+	}
+	return todo, nil
+}
+
+func (f *FakeTodoStore) UpdateTodo(todo domain.Todo) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.todos[todo.UID] = todo
+	return nil
+}
+
+func (f *FakeTodoStore) DeleteTodo(uid string) error {
+	if f.err != nil {
+		return f.err
+	}
+	delete(f.todos, uid)
+	return nil
+}
+
+func TestTodoListHandler(t *testing.T) {
+	yesterday := time.Date(2025, 9, 9, 0, 0, 0, 0, time.UTC)
+	friday := time.Date(2025, 9, 12, 17, 0, 0, 0, time.UTC)
+	completed := time.Date(2025, 9, 8, 12, 0, 0, 0, time.UTC)
+	store := NewFakeTodoStore(
+		domain.Todo{UID: "todo-1", Summary: "Someday", Status: domain.TodoNeedsAction},
+		domain.Todo{UID: "todo-2", Summary: "Report", Due: &friday, Priority: 1, PercentComplete: 40, Status: domain.TodoInProcess},
+		domain.Todo{UID: "todo-3", Summary: "Pay rent", Due: &yesterday, AllDay: true,
+			Recurrence: &domain.Recurrence{Frequency: "MONTHLY", Interval: 1}},
+		domain.Todo{UID: "todo-4", Summary: "Taxes", Status: domain.TodoCompleted, Completed: &completed},
+	)
+	timeProvider := &StubTimeProvider{FixedTime: time.Date(2025, 9, 10, 9, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name     string
+		options  TodoListOptions
+		expected string
+	}{
+		{
+			name: "open todos",
+			expected: "[ ] Pay rent (due 2025-09-09, overdue, repeats monthly)\n" +
+				"[ ] Report (due 2025-09-12 17:00, priority 1, 40%)\n" +
+				"[ ] Someday\n",
+		},
+		{
+			name:    "all todos with UIDs",
+			options: TodoListOptions{All: true, ShowUID: true},
+			expected: "[ ] Pay rent (due 2025-09-09, overdue, repeats monthly) [todo-3]\n" +
+				"[ ] Report (due 2025-09-12 17:00, priority 1, 40%) [todo-2]\n" +
+				"[ ] Someday [todo-1]\n" +
+				"[x] Taxes [todo-4]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var output bytes.Buffer
+			if err := TodoListHandler(store, timeProvider, &output, tt.options); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if output.String() != tt.expected {
+				t.Errorf("expected output:\n%s\ngot:\n%s", tt.expected, output.String())
+			}
+		})
+	}
+}
+
+func TestTodoNewHandler(t *testing.T) {
+	timeProvider := &StubTimeProvider{FixedTime: time.Date(2025, 9, 10, 9, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name        string
+		opts        TodoNewOptions
+		expectError bool
+		check       func(t *testing.T, todo domain.Todo)
+	}{
+		{
+			name: "due date",
+			opts: TodoNewOptions{Title: "Call bank", Due: "2025-09-15", Priority: 3},
+			check: func(t *testing.T, todo domain.Todo) {
+				if !todo.AllDay || todo.Due == nil || !todo.Due.Equal(time.Date(2025, 9, 15, 0, 0, 0, 0, time.UTC)) {
+					t.Errorf("expected all-day due date 2025-09-15, got %v", todo.Due)
+				}
+				if todo.Priority != 3 || todo.Status != domain.TodoNeedsAction {
+					t.Errorf("unexpected todo %+v", todo)
+				}
+			},
+		},
+		{
+			name: "due time",
+			opts: TodoNewOptions{Title: "Submit report", Due: "2025-09-12 17:00"},
+			check: func(t *testing.T, todo domain.Todo) {
+				if todo.AllDay || todo.Due == nil || !todo.Due.Equal(time.Date(2025, 9, 12, 17, 0, 0, 0, time.UTC)) {
+					t.Errorf("expected due time 2025-09-12 17:00, got %v", todo.Due)
+				}
+			},
+		},
+		{
+			name: "repeating",
+			opts: TodoNewOptions{Title: "Water plants", Due: "tomorrow", Repeat: "weekly", Count: 4},
+			check: func(t *testing.T, todo domain.Todo) {
+				if todo.Recurrence == nil || todo.Recurrence.Frequency != "WEEKLY" || *todo.Recurrence.Count != 4 {
+					t.Errorf("expected weekly recurrence, got %+v", todo.Recurrence)
+				}
+			},
+		},
+		{
+			name:        "repeating without due date",
+			opts:        TodoNewOptions{Title: "Water plants", Repeat: "weekly"},
+			expectError: true,
+		},
+		{
+			name:        "invalid priority",
+			opts:        TodoNewOptions{Title: "Call bank", Priority: 10},
+			expectError: true,
+		},
+		{
+			name:        "invalid due date",
+			opts:        TodoNewOptions{Title: "Call bank", Due: "someday"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewFakeTodoStore()
+			err := TodoNewHandler(store, timeProvider, &StubUIDGenerator{uid: "new-todo"}, tt.opts)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			todo, ok := store.todos["new-todo"]
+			if !ok {
+				t.Fatal("expected todo to be created")
+			}
+			if todo.Summary != tt.opts.Title {
+				t.Errorf("expected summary %q, got %q", tt.opts.Title, todo.Summary)
+			}
+			tt.check(t, todo)
+		})
+	}
+}
+
+func TestTodoDoneHandler(t *testing.T) {
+	due := time.Date(2025, 9, 9, 0, 0, 0, 0, time.UTC)
+	completed := time.Date(2025, 9, 8, 12, 0, 0, 0, time.UTC)
+	now := time.Date(2025, 9, 10, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		todo         domain.Todo
+		expectError  bool
+		expectOutput string
+		expectStatus string
+	}{
+		{
+			name:         "single todo",
+			todo:         domain.Todo{UID: "todo-1", Summary: "Call bank", Status: domain.TodoNeedsAction},
+			expectOutput: "Todo 'Call bank' completed\n",
+			expectStatus: domain.TodoCompleted,
+		},
+		{
+			name: "repeating todo",
+			todo: domain.Todo{UID: "todo-1", Summary: "Pay rent", Due: &due, AllDay: true,
+				Recurrence: &domain.Recurrence{Frequency: "MONTHLY", Interval: 1}},
+			expectOutput: "Todo 'Pay rent' done, next due 2025-10-09\n",
+			expectStatus: domain.TodoNeedsAction,
+		},
+		{
+			name:        "already completed",
+			todo:        domain.Todo{UID: "todo-1", Summary: "Taxes", Status: domain.TodoCompleted, Completed: &completed},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewFakeTodoStore(tt.todo)
+			var output bytes.Buffer
+
+			err := TodoDoneHandler(store, &StubTimeProvider{FixedTime: now}, &output, "todo-1")
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if output.String() != tt.expectOutput {
+				t.Errorf("expected output %q, got %q", tt.expectOutput, output.String())
+			}
+			if status := store.todos["todo-1"].Status; status != tt.expectStatus {
+				t.Errorf("expected status %q, got %q", tt.expectStatus, status)
+			}
+		})
+	}
+
+	t.Run("unknown UID", func(t *testing.T) {
+		err := TodoDoneHandler(NewFakeTodoStore(), &StubTimeProvider{FixedTime: now}, &bytes.Buffer{}, "missing")
+		if err == nil || !strings.Contains(err.Error(), "todo list") {
+			t.Errorf("expected a hint to list todos, got %v", err)
+		}
+	})
+}
+
+func TestTodoEditHandler(t *testing.T) {
+	due := time.Date(2025, 9, 12, 17, 0, 0, 0, time.UTC)
+	completed := time.Date(2025, 9, 8, 12, 0, 0, 0, time.UTC)
+	timeProvider := &StubTimeProvider{FixedTime: time.Date(2025, 9, 10, 9, 0, 0, 0, time.UTC)}
+	title := "Submit final report"
+	newDue := "2025-09-20"
+	noDue := "none"
+	priority := 2
+	badPriority := -1
+
+	tests := []struct {
+		name        string
+		options     TodoEditOptions
+		expectError bool
+		check       func(t *testing.T, todo domain.Todo)
+	}{
+		{
+			name:    "title and priority",
+			options: TodoEditOptions{Title: &title, Priority: &priority},
+			check: func(t *testing.T, todo domain.Todo) {
+				if todo.Summary != title || todo.Priority != 2 {
+					t.Errorf("unexpected todo %+v", todo)
+				}
+			},
+		},
+		{
+			name:    "due date",
+			options: TodoEditOptions{Due: &newDue},
+			check: func(t *testing.T, todo domain.Todo) {
+				if !todo.AllDay || todo.Due == nil || todo.Due.Format("2006-01-02") != newDue {
+					t.Errorf("expected all-day due date %s, got %v", newDue, todo.Due)
+				}
+			},
+		},
+		{
+			name:    "remove due date",
+			options: TodoEditOptions{Due: &noDue},
+			check: func(t *testing.T, todo domain.Todo) {
+				if todo.Due != nil {
+					t.Errorf("expected no due date, got %v", todo.Due)
+				}
+			},
+		},
+		{
+			name:    "reopen",
+			options: TodoEditOptions{Reopen: true},
+			check: func(t *testing.T, todo domain.Todo) {
+				if todo.IsDone() || todo.Completed != nil || todo.PercentComplete != 0 {
+					t.Errorf("expected an open todo, got %+v", todo)
+				}
+			},
+		},
+		{
+			name:        "invalid priority",
+			options:     TodoEditOptions{Priority: &badPriority},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewFakeTodoStore(domain.Todo{
+				UID: "todo-1", Summary: "Submit report", Due: &due,
+				Status: domain.TodoCompleted, PercentComplete: 100, Completed: &completed,
+			})
+
+			err := TodoEditHandler(store, timeProvider, "todo-1", tt.options)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			tt.check(t, store.todos["todo-1"])
+		})
+	}
+}
+
+func TestTodoDeleteHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		yes           bool
+		expectDeleted bool
+		expectOutput  string
+	}{
+		{
+			name:          "confirmed",
+			input:         "y\n",
+			expectDeleted: true,
+			expectOutput:  "Delete todo 'Call bank'? [y/N]: Todo 'Call bank' deleted\n",
+		},
+		{
+			name:         "declined",
+			input:        "n\n",
+			expectOutput: "Delete todo 'Call bank'? [y/N]: Cancelled.\n",
+		},
+		{
+			name:          "yes skips prompt",
+			yes:           true,
+			expectDeleted: true,
+			expectOutput:  "Todo 'Call bank' deleted\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewFakeTodoStore(domain.Todo{UID: "todo-1", Summary: "Call bank"})
+			var output bytes.Buffer
+
+			if err := TodoDeleteHandler(store, strings.NewReader(tt.input), &output, "todo-1", tt.yes); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if _, exists := store.todos["todo-1"]; exists == tt.expectDeleted {
+				t.Errorf("expected deleted %v, todo exists %v", tt.expectDeleted, exists)
+			}
+			if output.String() != tt.expectOutput {
+				t.Errorf("expected output %q, got %q", tt.expectOutput, output.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"io"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/render"
+	"github.com/NaMinhyeok/calcli/internal/util"
+)
+
+// WeekHandler displays the week holding date, the current week when nil, as
+// a time grid with events in the zone of timeProvider, marking today, laid
+// out by options
+func WeekHandler(reader EventReader, timeProvider util.TimeProvider, output io.Writer, date *time.Time, options CalendarOptions) error {
+	now := timeProvider.Now()
+	loc := now.Location()
+	targetDate := now
+	if date != nil {
+		targetDate = date.In(loc)
+	}
+
+	events, err := reader.ListEvents()
+	if err != nil {
+		return err
+	}
+
+	view := render.NewWeekView(targetDate, nil)
+	view.Today = now
+	view.Width = options.Width
+	view.Colors = options.Colors
+	view.WeekStart = options.WeekStart
+	view.Locale = options.Locale
+	days := view.Days()
+	firstDay := days[0]
+	lastDay := days[len(days)-1].AddDate(0, 0, 1).Add(-time.Second)
+	view.Events = domain.OccurrencesBetween(events, firstDay, lastDay)
+	return view.Render(output)
+}
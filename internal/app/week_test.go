@@ -0,0 +1,72 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+func TestWeekHandler(t *testing.T) {
+	count := 10
+	lister := FakeEventLister{events: []domain.Event{
+		{
+			Summary:    "Standup",
+			Start:      time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC),
+			End:        time.Date(2026, 10, 5, 9, 30, 0, 0, time.UTC),
+			Recurrence: &domain.Recurrence{Frequency: "WEEKLY", Count: &count},
+		},
+		{
+			Summary: "Next week",
+			Start:   time.Date(2026, 10, 20, 10, 0, 0, 0, time.UTC),
+			End:     time.Date(2026, 10, 20, 11, 0, 0, 0, time.UTC),
+		},
+	}}
+
+	tests := []struct {
+		name     string
+		date     *time.Time
+		expected []string
+	}{
+		{
+			name:     "given date",
+			date:     timePtr(time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)),
+			expected: []string{"Sun 11", "*Fri 16", "Sat 17", "▌Standup"},
+		},
+		{
+			name:     "current week",
+			expected: []string{"Sun 11", "*Fri 16", "Sat 17", "▌Standup"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeProvider := &StubTimeProvider{FixedTime: time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC)}
+			var buf bytes.Buffer
+			if err := WeekHandler(lister, timeProvider, &buf, tt.date, CalendarOptions{Width: 120}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			output := buf.String()
+			for _, want := range tt.expected {
+				if !strings.Contains(output, want) {
+					t.Errorf("expected output to contain %q:\n%s", want, output)
+				}
+			}
+			if strings.Contains(output, "Next week") {
+				t.Errorf("expected events of other weeks to be left out:\n%s", output)
+			}
+		})
+	}
+}
+
+func TestWeekHandler_Error(t *testing.T) {
+	lister := FakeEventLister{err: errors.New("permission denied")}
+	timeProvider := &StubTimeProvider{FixedTime: time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC)}
+	if err := WeekHandler(lister, timeProvider, &bytes.Buffer{}, nil, CalendarOptions{Width: 80}); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -2,8 +2,13 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/NaMinhyeok/calcli/internal/domain"
 )
@@ -22,13 +27,24 @@ type CalendarConfig struct {
 
 type DefaultsConfig struct {
 	DefaultCalendar string `json:"defaultCalendar"`
+	Timezone        string `json:"timezone"`     // IANA name of the display zone, system zone when empty
+	Template        string `json:"template"`     // default event template of list and search
+	WorkingHours    string `json:"workingHours"` // hours shown by the day view, e.g. "9-18"
+	WeekStart       string `json:"weekStart"`    // first day of weeks, e.g. "monday"; Sunday when empty
+	Locale          string `json:"locale"`       // language of month and weekday names, e.g. "de"
+	ShowWeekNumbers bool   `json:"showWeekNumbers"`
+	Organizer       string `json:"organizer"` // organizer of events with attendees, e.g. "Alice <alice@example.com>"
 }
 
 type CacheConfig struct {
 	Enabled bool `json:"enabled"`
-	MaxSize int  `json:"maxSize"`
+	MaxSize int  `json:"maxSize"` // events and journal entries kept, 0 for no limit
 }
 
+// DefaultCacheSize is the number of events cached without a config file.
+// Calendars larger than the cache only reuse the files read last.
+const DefaultCacheSize = 10000
+
 func Load(configPath string) (*Config, error) {
 	if _, err := os.Stat(configPath); os.IsNotExist(err) {
 		return defaultConfig(), nil
@@ -69,24 +85,139 @@ func (c *Config) GetCalendar(name string) (CalendarConfig, bool) {
 }
 
 func (c *Config) GetDefaultCalendar() (domain.Calendar, error) {
-	defaultName := c.Defaults.DefaultCalendar
-	if defaultName == "" {
-		defaultName = "home"
+	if _, exists := c.GetCalendar(c.defaultCalendarName()); !exists {
+		return domain.Calendar{}, os.ErrNotExist
 	}
 
-	calConfig, exists := c.GetCalendar(defaultName)
+	return c.Calendar(c.defaultCalendarName())
+}
+
+func (c *Config) defaultCalendarName() string {
+	if c.Defaults.DefaultCalendar == "" {
+		return "home"
+	}
+	return c.Defaults.DefaultCalendar
+}
+
+// Calendar returns the configured calendar with the given name
+func (c *Config) Calendar(name string) (domain.Calendar, error) {
+	calConfig, exists := c.GetCalendar(name)
 	if !exists {
-		return domain.Calendar{}, os.ErrNotExist
+		return domain.Calendar{}, fmt.Errorf("unknown calendar %q (available: %s)", name, strings.Join(c.CalendarNames(), ", "))
 	}
 
 	return domain.Calendar{
-		Name:     defaultName,
+		Name:     name,
 		Path:     expandPath(calConfig.Path),
 		Color:    calConfig.Color,
 		ReadOnly: calConfig.ReadOnly,
 	}, nil
 }
 
+// CalendarNames returns the names of all configured calendars, sorted
+func (c *Config) CalendarNames() []string {
+	names := make([]string, 0, len(c.Calendars))
+	for name := range c.Calendars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SelectCalendars resolves calendar names given on the command line. Names
+// may be comma separated; "all" selects every calendar and no names select
+// the default calendar.
+func (c *Config) SelectCalendars(names []string) ([]domain.Calendar, error) {
+	var selected []string
+	for _, name := range names {
+		for _, part := range strings.Split(name, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				selected = append(selected, part)
+			}
+		}
+	}
+
+	if len(selected) == 0 {
+		selected = []string{c.defaultCalendarName()}
+	}
+
+	for _, name := range selected {
+		if name == "all" {
+			selected = c.CalendarNames()
+			break
+		}
+	}
+
+	var calendars []domain.Calendar
+	seen := make(map[string]bool)
+	for _, name := range selected {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		calendar, err := c.Calendar(name)
+		if err != nil {
+			return nil, err
+		}
+		calendars = append(calendars, calendar)
+	}
+	return calendars, nil
+}
+
+// Location returns the configured display time zone, falling back to the
+// system zone when none is set
+func (c *Config) Location() (*time.Location, error) {
+	if c.Defaults.Timezone == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(c.Defaults.Timezone)
+}
+
+// WorkingHours returns the first and last hour of the configured working
+// hours, both zero when none are set
+func (c *Config) WorkingHours() (int, int, error) {
+	if c.Defaults.WorkingHours == "" {
+		return 0, 0, nil
+	}
+
+	first, last, ok := strings.Cut(c.Defaults.WorkingHours, "-")
+	start, err1 := strconv.Atoi(strings.TrimSpace(first))
+	end, err2 := strconv.Atoi(strings.TrimSpace(last))
+	if !ok || err1 != nil || err2 != nil || start < 0 || end > 24 || start >= end {
+		return 0, 0, fmt.Errorf("invalid working hours %q: expected hours like 9-18", c.Defaults.WorkingHours)
+	}
+	return start, end, nil
+}
+
+// WeekStart returns the configured first day of weeks, Sunday when none is
+// set. Days are named in English, in full or by their first three letters.
+func (c *Config) WeekStart() (time.Weekday, error) {
+	name := strings.ToLower(strings.TrimSpace(c.Defaults.WeekStart))
+	if name == "" {
+		return time.Sunday, nil
+	}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		full := strings.ToLower(day.String())
+		if name == full || name == full[:3] {
+			return day, nil
+		}
+	}
+	return time.Sunday, fmt.Errorf("invalid week start %q: expected a weekday such as monday", c.Defaults.WeekStart)
+}
+
+// GetDefaultIndexPath returns the persistent cache index, stored in a
+// cache directory next to the config file
+func GetDefaultIndexPath() string {
+	return filepath.Join(filepath.Dir(GetDefaultConfigPath()), "cache", "index.json")
+}
+
+// GetDefaultRemindStatePath returns the file recording the reminders
+// already fired by 'calcli remind', stored next to the config file
+func GetDefaultRemindStatePath() string {
+	return filepath.Join(filepath.Dir(GetDefaultConfigPath()), "remind-state.json")
+}
+
 // defaultConfig returns a sensible default configuration
 func defaultConfig() *Config {
 	home := os.Getenv("HOME")
@@ -103,7 +234,7 @@ func defaultConfig() *Config {
 		},
 		Cache: CacheConfig{
 			Enabled: true,
-			MaxSize: 1000,
+			MaxSize: DefaultCacheSize,
 		},
 	}
 }
@@ -3,7 +3,9 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestLoad(t *testing.T) {
@@ -94,6 +96,10 @@ func TestLoad_NoConfigFile(t *testing.T) {
 	if config.Defaults.DefaultCalendar != "home" {
 		t.Errorf("expected default calendar 'home', got %s", config.Defaults.DefaultCalendar)
 	}
+
+	if config.Cache.MaxSize != DefaultCacheSize {
+		t.Errorf("expected cache size %d, got %d", DefaultCacheSize, config.Cache.MaxSize)
+	}
 }
 
 func TestGetDefaultCalendar(t *testing.T) {
@@ -112,3 +118,151 @@ func TestGetDefaultCalendar(t *testing.T) {
 		t.Error("expected home calendar to be writable")
 	}
 }
+
+func TestLocation(t *testing.T) {
+	config := defaultConfig()
+
+	loc, err := config.Location()
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if loc != time.Local {
+		t.Errorf("expected system zone by default, got %v", loc)
+	}
+
+	config.Defaults.Timezone = "Europe/Berlin"
+	loc, err = config.Location()
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	if loc.String() != "Europe/Berlin" {
+		t.Errorf("expected Europe/Berlin, got %v", loc)
+	}
+
+	config.Defaults.Timezone = "Not/AZone"
+	if _, err := config.Location(); err == nil {
+		t.Error("expected error for unknown time zone")
+	}
+}
+
+func TestWorkingHours(t *testing.T) {
+	tests := []struct {
+		value       string
+		first, last int
+		wantErr     bool
+	}{
+		{value: "", first: 0, last: 0},
+		{value: "9-18", first: 9, last: 18},
+		{value: "7 - 24", first: 7, last: 24},
+		{value: "18-9", wantErr: true},
+		{value: "9:00-18:00", wantErr: true},
+		{value: "9", wantErr: true},
+		{value: "0-25", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			config := &Config{Defaults: DefaultsConfig{WorkingHours: tt.value}}
+			first, last, err := config.WorkingHours()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error for %q", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if first != tt.first || last != tt.last {
+				t.Errorf("expected %d-%d, got %d-%d", tt.first, tt.last, first, last)
+			}
+		})
+	}
+}
+
+func TestWeekStart(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Weekday
+		wantErr bool
+	}{
+		{value: "", want: time.Sunday},
+		{value: "monday", want: time.Monday},
+		{value: "Mon", want: time.Monday},
+		{value: " Saturday ", want: time.Saturday},
+		{value: "montag", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			config := &Config{Defaults: DefaultsConfig{WeekStart: tt.value}}
+			got, err := config.WeekStart()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error for %q", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSelectCalendars(t *testing.T) {
+	config := &Config{
+		Calendars: map[string]CalendarConfig{
+			"home":     {Path: "/cal/home"},
+			"work":     {Path: "/cal/work"},
+			"holidays": {Path: "/cal/holidays", ReadOnly: true},
+		},
+		Defaults: DefaultsConfig{DefaultCalendar: "work"},
+	}
+
+	tests := []struct {
+		name        string
+		names       []string
+		expected    []string
+		expectError bool
+	}{
+		{"default calendar", nil, []string{"work"}, false},
+		{"single calendar", []string{"home"}, []string{"home"}, false},
+		{"repeated flag", []string{"home", "holidays"}, []string{"home", "holidays"}, false},
+		{"comma separated", []string{"home,work"}, []string{"home", "work"}, false},
+		{"duplicates", []string{"home", "home"}, []string{"home"}, false},
+		{"all calendars", []string{"all"}, []string{"holidays", "home", "work"}, false},
+		{"unknown calendar", []string{"school"}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calendars, err := config.SelectCalendars(tt.names)
+			if tt.expectError {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			var names []string
+			for _, calendar := range calendars {
+				names = append(names, calendar.Name)
+			}
+			if strings.Join(names, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("expected calendars %v, got %v", tt.expected, names)
+			}
+		})
+	}
+
+	calendars, _ := config.SelectCalendars([]string{"holidays"})
+	if calendars[0].Path != "/cal/holidays" || !calendars[0].ReadOnly {
+		t.Errorf("expected configured path and flags, got %+v", calendars[0])
+	}
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+// Alarm is a VALARM reminder. It triggers at At when set, and otherwise
+// Trigger after the start of the event, or after its end when RelatedEnd
+// is set. Reminders before the event have a negative Trigger.
+type Alarm struct {
+	Action      string // DISPLAY or AUDIO
+	Description string
+	Trigger     time.Duration
+	RelatedEnd  bool
+	At          *time.Time
+}
+
+// TriggerTime returns when the alarm fires for the given occurrence
+func (a Alarm) TriggerTime(event Event) time.Time {
+	switch {
+	case a.At != nil:
+		return *a.At
+	case a.RelatedEnd:
+		return event.EndTime().Add(a.Trigger)
+	}
+	return event.Start.Add(a.Trigger)
+}
+
+// Equal reports whether both alarms describe the same reminder
+func (a Alarm) Equal(b Alarm) bool {
+	if (a.At == nil) != (b.At == nil) || (a.At != nil && !a.At.Equal(*b.At)) {
+		return false
+	}
+	return a.Action == b.Action && a.Description == b.Description &&
+		a.Trigger == b.Trigger && a.RelatedEnd == b.RelatedEnd
+}
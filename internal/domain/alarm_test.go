@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAlarm_TriggerTime(t *testing.T) {
+	event := Event{
+		Start: time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+		End:   time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC),
+	}
+	at := time.Date(2025, 8, 31, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		alarm Alarm
+		want  time.Time
+	}{
+		{
+			name:  "before start",
+			alarm: Alarm{Trigger: -15 * time.Minute},
+			want:  time.Date(2025, 9, 1, 9, 45, 0, 0, time.UTC),
+		},
+		{
+			name:  "relative to end",
+			alarm: Alarm{Trigger: 5 * time.Minute, RelatedEnd: true},
+			want:  time.Date(2025, 9, 1, 11, 5, 0, 0, time.UTC),
+		},
+		{
+			name:  "absolute",
+			alarm: Alarm{Trigger: -time.Hour, At: &at},
+			want:  at,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.alarm.TriggerTime(event); !got.Equal(tt.want) {
+				t.Errorf("TriggerTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
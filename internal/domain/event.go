@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"fmt"
+	"sort"
 	"time"
 )
 
@@ -13,20 +15,275 @@ type Event struct {
 	Location    string
 	Categories  []string
 	AllDay      bool
+	Floating    bool // DATE-TIME without zone: same wall-clock time everywhere
 	Calendar    string
 	Recurrence  *Recurrence
+	ExDates     []time.Time // EXDATE: occurrences removed from the set
+	RDates      []time.Time // RDATE: occurrences added to the set
+	Organizer   *Attendee
+	Attendees   []Attendee
+	Alarms      []Alarm
+
+	// RecurrenceID is set on an override and holds the original start of
+	// the occurrence it replaces. Overrides holds the overrides of a series.
+	RecurrenceID *time.Time
+	Overrides    []Event
+
+	// SeriesRule is the RRULE of the series an expanded occurrence belongs
+	// to; ExpandRecurrence also sets RecurrenceID on every occurrence
+	SeriesRule *Recurrence
+
+	// Source is the iCalendar component the event was parsed from. Writing
+	// the event back patches it, keeping properties calcli doesn't model.
+	Source string
+
+	// SourceZones are the VTIMEZONE components Source refers to, written
+	// back as they were
+	SourceZones []string
+}
+
+// Attendee is the ORGANIZER or an ATTENDEE of an event. Role, Status and
+// RSVP are only meaningful for attendees.
+type Attendee struct {
+	Email  string // calendar address without "mailto:"
+	Name   string // CN
+	Role   string // ROLE such as REQ-PARTICIPANT, empty when absent
+	Status string // PARTSTAT such as ACCEPTED, empty when absent
+	RSVP   bool
+}
+
+// String renders the attendee as "Name <email>", or the bare address when
+// it has no name
+func (a Attendee) String() string {
+	if a.Name == "" {
+		return a.Email
+	}
+	return fmt.Sprintf("%s <%s>", a.Name, a.Email)
 }
 
+// Recurrence mirrors an RFC 5545 RRULE. The BY* slices are empty when the
+// corresponding rule part is absent.
 type Recurrence struct {
-	Frequency string
-	Interval  int
-	Count     *int
-	Until     *time.Time
+	Frequency  string
+	Interval   int
+	Count      *int
+	Until      *time.Time
+	BySecond   []int
+	ByMinute   []int
+	ByHour     []int
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByYearDay  []int
+	ByWeekNo   []int
+	ByMonth    []int
+	BySetPos   []int
+	WeekStart  *time.Weekday // WKST, Monday when nil
+}
+
+// WeekdayNum is a BYDAY entry such as "TU", "1MO" or "-1FR".
+// N is zero when the weekday applies to every week of the period.
+type WeekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// IsRecurring reports whether the event describes more than one occurrence
+func (e Event) IsRecurring() bool {
+	return e.Recurrence != nil || len(e.RDates) > 0
+}
+
+// OverrideFor returns the override replacing the occurrence starting at t
+func (e Event) OverrideFor(t time.Time) (Event, bool) {
+	for _, override := range e.Overrides {
+		if e.replacedBy(override, t) {
+			return override, true
+		}
+	}
+	return Event{}, false
+}
+
+// replacedBy reports whether override replaces the occurrence starting at t.
+// All-day occurrences are matched by date.
+func (e Event) replacedBy(override Event, t time.Time) bool {
+	if override.RecurrenceID == nil {
+		return false
+	}
+	return override.RecurrenceID.Equal(t) || (e.AllDay && sameDate(*override.RecurrenceID, t))
+}
+
+// OriginalStart returns the start an expanded instance has in its series,
+// before an override moved it
+func (e Event) OriginalStart() time.Time {
+	if e.RecurrenceID != nil {
+		return *e.RecurrenceID
+	}
+	return e.Start
+}
+
+// ExcludeOccurrence returns the series without the occurrence starting at
+// t: t is added to EXDATE and the override replacing it is dropped
+func (e Event) ExcludeOccurrence(t time.Time) Event {
+	e.ExDates = append(append([]time.Time(nil), e.ExDates...), t)
+
+	var overrides []Event
+	for _, override := range e.Overrides {
+		if !e.replacedBy(override, t) {
+			overrides = append(overrides, override)
+		}
+	}
+	e.Overrides = overrides
+	return e
+}
+
+// In returns the event with its times expressed in loc. Floating and
+// all-day events keep their wall-clock time instead of being converted.
+func (e Event) In(loc *time.Location) Event {
+	if loc == nil {
+		return e
+	}
+	keepWallClock := e.Floating || e.AllDay
+	e.Start = inLocation(e.Start, loc, keepWallClock)
+	e.End = inLocation(e.End, loc, keepWallClock)
+	return e
+}
+
+func inLocation(t time.Time, loc *time.Location, keepWallClock bool) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	if keepWallClock {
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+	}
+	return t.In(loc)
 }
 
+// Duration returns the length of the event; all-day events without an end
+// last one day
 func (e Event) Duration() time.Duration {
-	if e.AllDay {
-		return 24 * time.Hour
+	return e.EndTime().Sub(e.Start)
+}
+
+// EndTime returns the exclusive end of the event. Per RFC 5545, all-day
+// events without a later DTEND end at the following midnight and timed
+// events without one end when they start.
+func (e Event) EndTime() time.Time {
+	switch {
+	case e.AllDay && !e.End.After(e.Start):
+		return e.Start.AddDate(0, 0, 1)
+	case e.End.Before(e.Start):
+		return e.Start
+	}
+	return e.End
+}
+
+// LastDate returns midnight of the last day the event covers. The end is
+// exclusive, so an event ending at midnight does not cover that day.
+func (e Event) LastDate() time.Time {
+	end := e.EndTime()
+	if end.After(e.Start) {
+		end = end.Add(-time.Nanosecond)
+	}
+	return startOfDay(end)
+}
+
+// Dates returns midnight of every day the event covers, in the event's zone
+func (e Event) Dates() []time.Time {
+	last := e.LastDate()
+	var dates []time.Time
+	for day := startOfDay(e.Start); !day.After(last); day = day.AddDate(0, 0, 1) {
+		dates = append(dates, day)
+	}
+	return dates
+}
+
+// IsMultiDay reports whether the event covers more than one day
+func (e Event) IsMultiDay() bool {
+	return e.LastDate().After(startOfDay(e.Start))
+}
+
+// Overlaps reports whether the event intersects the range [from, to].
+// Events ending exactly at from do not overlap it.
+func (e Event) Overlaps(from, to time.Time) bool {
+	if e.Start.After(to) {
+		return false
+	}
+	return !e.Start.Before(from) || e.EndTime().After(from)
+}
+
+// IsOngoing reports whether the event is in progress at now
+func (e Event) IsOngoing(now time.Time) bool {
+	return !now.Before(e.Start) && now.Before(e.EndTime())
+}
+
+// SortEvents orders events by their start, all-day events first among
+// those starting together, then by summary
+func SortEvents(events []Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := events[i], events[j]
+		if !a.Start.Equal(b.Start) {
+			return a.Start.Before(b.Start)
+		}
+		if a.AllDay != b.AllDay {
+			return a.AllDay
+		}
+		return a.Summary < b.Summary
+	})
+}
+
+// Period is a span of time; its end is exclusive
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration returns the length of the period
+func (p Period) Duration() time.Duration {
+	return p.End.Sub(p.Start)
+}
+
+// FreePeriods returns the parts of [from, to) not taken by any timed event.
+// All-day events do not take any time.
+func FreePeriods(events []Event, from, to time.Time) []Period {
+	var busy []Period
+	for _, event := range events {
+		if event.AllDay {
+			continue
+		}
+		start, end := event.Start, event.EndTime()
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if start.Before(end) {
+			busy = append(busy, Period{Start: start, End: end})
+		}
+	}
+	sort.Slice(busy, func(i, j int) bool { return busy[i].Start.Before(busy[j].Start) })
+
+	var free []Period
+	cursor := from
+	for _, period := range busy {
+		if period.Start.After(cursor) {
+			free = append(free, Period{Start: cursor, End: period.Start})
+		}
+		if period.End.After(cursor) {
+			cursor = period.End
+		}
+	}
+	if cursor.Before(to) {
+		free = append(free, Period{Start: cursor, End: to})
 	}
-	return e.End.Sub(e.Start)
+	return free
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func sameDate(a, b time.Time) bool {
+	y1, m1, d1 := a.Date()
+	y2, m2, d2 := b.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
 }
@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEvent_Spans(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    Event
+		duration time.Duration
+		dates    int
+		multiDay bool
+		lastDate time.Time
+	}{
+		{
+			name: "timed event",
+			event: Event{
+				Start: time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+				End:   time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC),
+			},
+			duration: time.Hour,
+			dates:    1,
+			lastDate: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "timed event ending at midnight",
+			event: Event{
+				Start: time.Date(2025, 9, 1, 22, 0, 0, 0, time.UTC),
+				End:   time.Date(2025, 9, 2, 0, 0, 0, 0, time.UTC),
+			},
+			duration: 2 * time.Hour,
+			dates:    1,
+			lastDate: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "overnight event",
+			event: Event{
+				Start: time.Date(2025, 9, 1, 22, 0, 0, 0, time.UTC),
+				End:   time.Date(2025, 9, 2, 6, 0, 0, 0, time.UTC),
+			},
+			duration: 8 * time.Hour,
+			dates:    2,
+			multiDay: true,
+			lastDate: time.Date(2025, 9, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "all-day event without end",
+			event: Event{
+				Start:  time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+				AllDay: true,
+			},
+			duration: 24 * time.Hour,
+			dates:    1,
+			lastDate: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "three-day conference",
+			event: Event{
+				Start:  time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+				End:    time.Date(2025, 9, 4, 0, 0, 0, 0, time.UTC),
+				AllDay: true,
+			},
+			duration: 72 * time.Hour,
+			dates:    3,
+			multiDay: true,
+			lastDate: time.Date(2025, 9, 3, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.event.Duration(); got != tt.duration {
+				t.Errorf("Duration() = %v, want %v", got, tt.duration)
+			}
+			if got := len(tt.event.Dates()); got != tt.dates {
+				t.Errorf("len(Dates()) = %d, want %d", got, tt.dates)
+			}
+			if got := tt.event.IsMultiDay(); got != tt.multiDay {
+				t.Errorf("IsMultiDay() = %v, want %v", got, tt.multiDay)
+			}
+			if got := tt.event.LastDate(); !got.Equal(tt.lastDate) {
+				t.Errorf("LastDate() = %v, want %v", got, tt.lastDate)
+			}
+		})
+	}
+}
+
+func TestEvent_Overlaps(t *testing.T) {
+	conference := Event{
+		Start:  time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		End:    time.Date(2025, 9, 4, 0, 0, 0, 0, time.UTC),
+		AllDay: true,
+	}
+
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+		want bool
+	}{
+		{"range before", time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 8, 31, 23, 59, 59, 0, time.UTC), false},
+		{"middle day", time.Date(2025, 9, 2, 0, 0, 0, 0, time.UTC), time.Date(2025, 9, 2, 23, 59, 59, 0, time.UTC), true},
+		{"last day", time.Date(2025, 9, 3, 0, 0, 0, 0, time.UTC), time.Date(2025, 9, 3, 23, 59, 59, 0, time.UTC), true},
+		{"exclusive end", time.Date(2025, 9, 4, 0, 0, 0, 0, time.UTC), time.Date(2025, 9, 4, 23, 59, 59, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := conference.Overlaps(tt.from, tt.to); got != tt.want {
+				t.Errorf("Overlaps() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortEvents(t *testing.T) {
+	morning := time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)
+	events := []Event{
+		{Summary: "Lunch", Start: morning.Add(3 * time.Hour)},
+		{Summary: "Standup", Start: morning},
+		{Summary: "Holiday", Start: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), AllDay: true},
+		{Summary: "Breakfast", Start: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)},
+		{Summary: "Review", Start: morning},
+	}
+
+	SortEvents(events)
+
+	want := []string{"Holiday", "Breakfast", "Review", "Standup", "Lunch"}
+	for i, event := range events {
+		if event.Summary != want[i] {
+			t.Errorf("position %d: expected %s, got %s", i, want[i], event.Summary)
+		}
+	}
+}
+
+func TestEvent_IsOngoing(t *testing.T) {
+	meeting := Event{
+		Start: time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+		End:   time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{"before", time.Date(2025, 9, 1, 9, 59, 0, 0, time.UTC), false},
+		{"at start", time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC), true},
+		{"during", time.Date(2025, 9, 1, 10, 30, 0, 0, time.UTC), true},
+		{"at end", time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := meeting.IsOngoing(tt.now); got != tt.want {
+				t.Errorf("IsOngoing() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFreePeriods(t *testing.T) {
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, 9, day, hour, minute, 0, 0, time.UTC)
+	}
+	events := []Event{
+		{Summary: "Review", Start: at(1, 10, 0), End: at(1, 11, 30)},
+		{Summary: "Standup", Start: at(1, 9, 0), End: at(1, 9, 15)},
+		{Summary: "Planning", Start: at(1, 11, 0), End: at(1, 12, 0)},
+		{Summary: "Holiday", Start: at(1, 0, 0), AllDay: true},
+		{Summary: "Night shift", Start: at(1, 17, 0), End: at(2, 6, 0)},
+		{Summary: "Early call", Start: at(1, 7, 0), End: at(1, 8, 30)},
+	}
+
+	free := FreePeriods(events, at(1, 8, 0), at(1, 18, 0))
+
+	want := []Period{
+		{Start: at(1, 8, 30), End: at(1, 9, 0)},
+		{Start: at(1, 9, 15), End: at(1, 10, 0)},
+		{Start: at(1, 12, 0), End: at(1, 17, 0)},
+	}
+	if len(free) != len(want) {
+		t.Fatalf("expected %d free periods, got %d: %v", len(want), len(free), free)
+	}
+	for i := range want {
+		if !free[i].Start.Equal(want[i].Start) || !free[i].End.Equal(want[i].End) {
+			t.Errorf("period %d: expected %v, got %v", i, want[i], free[i])
+		}
+	}
+
+	if free := FreePeriods(nil, at(1, 8, 0), at(1, 18, 0)); len(free) != 1 || free[0].Duration() != 10*time.Hour {
+		t.Errorf("expected the whole range to be free, got %v", free)
+	}
+}
+
+func TestExpandRecurrence_MultiDayInstances(t *testing.T) {
+	count := 3
+	event := Event{
+		UID:        "retreat",
+		Summary:    "Retreat",
+		Start:      time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		End:        time.Date(2025, 9, 3, 0, 0, 0, 0, time.UTC),
+		AllDay:     true,
+		Recurrence: &Recurrence{Frequency: "MONTHLY", Interval: 1, Count: &count},
+	}
+
+	// The October instance starts before the range but still covers it
+	rangeStart := time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC)
+	rangeEnd := time.Date(2025, 10, 2, 23, 59, 59, 0, time.UTC)
+
+	instances := ExpandRecurrence(event, rangeStart, rangeEnd)
+
+	if len(instances) != 1 {
+		t.Fatalf("Expected 1 instance, got %d", len(instances))
+	}
+	if !instances[0].Start.Equal(time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected instance starting Oct 1, got %v", instances[0].Start)
+	}
+	if len(instances[0].Dates()) != 2 {
+		t.Errorf("Expected instance to cover 2 days, got %d", len(instances[0].Dates()))
+	}
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// Journal is a VJOURNAL entry, such as the notes of a meeting or a day
+type Journal struct {
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time // DTSTART, the day or time the entry belongs to
+	AllDay      bool      // DTSTART is a date
+	Floating    bool      // DTSTART is bound to no zone
+	Calendar    string
+
+	// Source is the iCalendar component the entry was parsed from, see
+	// Event.Source
+	Source string
+
+	// SourceZones are the VTIMEZONE components Source refers to
+	SourceZones []string
+}
+
+// Date returns midnight of the day the entry belongs to in loc
+func (j Journal) Date(loc *time.Location) time.Time {
+	return startOfDay(inLocation(j.Start, loc, j.AllDay || j.Floating))
+}
+
+// OnDate reports whether the entry belongs to the day of date in loc
+func (j Journal) OnDate(date time.Time, loc *time.Location) bool {
+	return sameDate(j.Date(loc), date.In(loc))
+}
+
+// SortJournals orders entries by their start, then by summary
+func SortJournals(journals []Journal) {
+	sort.SliceStable(journals, func(i, k int) bool {
+		a, b := journals[i], journals[k]
+		if !a.Start.Equal(b.Start) {
+			return a.Start.Before(b.Start)
+		}
+		return a.Summary < b.Summary
+	})
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJournal_Date(t *testing.T) {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		journal  Journal
+		expected string
+	}{
+		{
+			name:     "date entry keeps its day",
+			journal:  Journal{Start: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), AllDay: true},
+			expected: "2025-09-01",
+		},
+		{
+			name:     "floating entry keeps its wall clock",
+			journal:  Journal{Start: time.Date(2025, 9, 1, 20, 0, 0, 0, time.UTC), Floating: true},
+			expected: "2025-09-01",
+		},
+		{
+			name:     "timed entry moves to the display zone",
+			journal:  Journal{Start: time.Date(2025, 9, 1, 20, 0, 0, 0, time.UTC)},
+			expected: "2025-09-02",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date := tt.journal.Date(seoul)
+			if got := date.Format("2006-01-02"); got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+			if date.Location() != seoul || date.Hour() != 0 {
+				t.Errorf("expected midnight in %v, got %v", seoul, date)
+			}
+			if !tt.journal.OnDate(date.Add(13*time.Hour), seoul) {
+				t.Errorf("expected entry to be on %s", tt.expected)
+			}
+		})
+	}
+}
@@ -2,61 +2,88 @@ package domain
 
 import (
 	"fmt"
+	"sort"
 	"time"
 )
 
 // DefaultMaxExpansions is the default maximum number of recurrence instances to expand
 const DefaultMaxExpansions = 5000
 
+// maxEmptyPeriods bounds how many consecutive periods may yield no occurrence
+// before expansion gives up, so rules that can never match (e.g. the 30th of
+// February) terminate. Sub-daily rules skip the periods of days and hours
+// their rule parts exclude without counting them.
+const maxEmptyPeriods = 10000
+
 // ExpandRecurrence generates instances of a recurring event within a time range
 func ExpandRecurrence(event Event, rangeStart, rangeEnd time.Time) []Event {
 	return ExpandRecurrenceWithLimit(event, rangeStart, rangeEnd, DefaultMaxExpansions)
 }
 
+// OccurrencesBetween expands recurring events and returns the instances
+// covering any part of [from, to], along with single events doing so
+func OccurrencesBetween(events []Event, from, to time.Time) []Event {
+	var occurrences []Event
+	for _, event := range events {
+		for _, instance := range ExpandRecurrence(event, from, to) {
+			if instance.Overlaps(from, to) {
+				occurrences = append(occurrences, instance)
+			}
+		}
+	}
+	return occurrences
+}
+
 // ExpandRecurrenceWithLimit generates instances with a custom expansion limit
 func ExpandRecurrenceWithLimit(event Event, rangeStart, rangeEnd time.Time, maxExpansions int) []Event {
-	if event.Recurrence == nil {
+	if !event.IsRecurring() {
 		return []Event{event}
 	}
 
 	var instances []Event
-	current := event.Start
+	iter := newRecurrenceSet(event)
 	hitLimit := false
 
-	for i := 0; i < maxExpansions; i++ {
-		// Check if we've passed the range end
-		if current.After(rangeEnd) {
+	for i := 0; ; i++ {
+		current, ok := iter.next()
+		if !ok {
 			break
 		}
 
-		// Check UNTIL condition
-		if event.Recurrence.Until != nil && current.After(*event.Recurrence.Until) {
+		// Check if we've passed the range end
+		if current.After(rangeEnd) {
 			break
 		}
 
-		// Check COUNT condition
-		if event.Recurrence.Count != nil && i >= *event.Recurrence.Count {
+		// Check if we hit the limit
+		if i == maxExpansions {
+			hitLimit = true
 			break
 		}
 
 		// If instance is within range, add it
-		if !current.Before(rangeStart) && !current.After(rangeEnd) {
-			instance := event
-			instance.Start = current
-			instance.End = current.Add(event.Duration())
-			instance.Recurrence = nil // Expanded instances are not recurring
+		instance := event.instanceAt(current)
+		if instance.Overlaps(rangeStart, rangeEnd) {
 			instances = append(instances, instance)
 		}
+	}
 
-		// Move to next occurrence
-		current = nextOccurrence(current, event.Recurrence)
-
-		// Check if we hit the limit
-		if i == maxExpansions-1 && !current.After(rangeEnd) {
-			hitLimit = true
+	// Overrides may move an occurrence from outside the range into it
+	for _, override := range event.Overrides {
+		if override.RecurrenceID == nil || iter.isExcluded(*override.RecurrenceID) {
+			continue
+		}
+		original := Event{Start: *override.RecurrenceID, End: override.RecurrenceID.Add(event.Duration())}
+		if original.Overlaps(rangeStart, rangeEnd) {
+			continue
+		}
+		if override.Overlaps(rangeStart, rangeEnd) {
+			instances = append(instances, event.instanceAt(*override.RecurrenceID))
 		}
 	}
 
+	sort.SliceStable(instances, func(i, j int) bool { return instances[i].Start.Before(instances[j].Start) })
+
 	// Add warning if limit was hit
 	if hitLimit && len(instances) > 0 {
 		// Store warning in the last instance's description (non-invasive)
@@ -71,22 +98,528 @@ func ExpandRecurrenceWithLimit(event Event, rangeStart, rangeEnd time.Time, maxE
 	return instances
 }
 
-func nextOccurrence(current time.Time, rec *Recurrence) time.Time {
+// instanceAt builds the occurrence of a series starting at t, substituting
+// the matching RECURRENCE-ID override if there is one
+func (e Event) instanceAt(t time.Time) Event {
+	instance := e
+	if override, ok := e.OverrideFor(t); ok {
+		instance = override
+		if instance.Calendar == "" {
+			instance.Calendar = e.Calendar
+		}
+	} else {
+		instance.Start = t
+		if e.AllDay {
+			// Count days rather than hours so spans survive DST changes
+			instance.End = t.AddDate(0, 0, daysBetween(e.Start, e.EndTime()))
+		} else {
+			instance.End = t.Add(e.Duration())
+		}
+	}
+
+	// Expanded instances are not recurring but remember their series
+	if instance.RecurrenceID == nil {
+		original := t
+		instance.RecurrenceID = &original
+	}
+	instance.SeriesRule = e.Recurrence
+	instance.Recurrence = nil
+	instance.ExDates = nil
+	instance.RDates = nil
+	instance.Overrides = nil
+	return instance
+}
+
+// recurrenceSet merges the RRULE occurrences with RDATE and removes EXDATE
+// values, yielding the final recurrence set in order.
+type recurrenceSet struct {
+	rule    *recurrenceIterator
+	peeked  *time.Time
+	rdates  []time.Time
+	exdates []time.Time
+	allDay  bool
+	last    *time.Time
+}
+
+func newRecurrenceSet(event Event) *recurrenceSet {
+	set := &recurrenceSet{
+		exdates: event.ExDates,
+		allDay:  event.AllDay,
+	}
+
+	if event.Recurrence != nil {
+		set.rule = newRecurrenceIterator(event.Start, event.Recurrence)
+	} else {
+		// Without RRULE, DTSTART is the only rule-based occurrence
+		start := event.Start
+		set.peeked = &start
+	}
+
+	set.rdates = append([]time.Time(nil), event.RDates...)
+	sort.Slice(set.rdates, func(i, j int) bool { return set.rdates[i].Before(set.rdates[j]) })
+
+	return set
+}
+
+func (s *recurrenceSet) next() (time.Time, bool) {
+	for {
+		if s.peeked == nil && s.rule != nil {
+			if t, ok := s.rule.next(); ok {
+				s.peeked = &t
+			} else {
+				s.rule = nil
+			}
+		}
+
+		var current time.Time
+		switch {
+		case s.peeked != nil && (len(s.rdates) == 0 || !s.rdates[0].Before(*s.peeked)):
+			current = *s.peeked
+			s.peeked = nil
+		case len(s.rdates) > 0:
+			current = s.rdates[0]
+			s.rdates = s.rdates[1:]
+		default:
+			return time.Time{}, false
+		}
+
+		if s.last != nil && current.Equal(*s.last) {
+			continue
+		}
+		s.last = &current
+
+		if s.isExcluded(current) {
+			continue
+		}
+		return current, true
+	}
+}
+
+// isExcluded matches EXDATE values exactly, or by calendar date for all-day
+// events whose EXDATE may have been written in another zone.
+func (s *recurrenceSet) isExcluded(t time.Time) bool {
+	for _, ex := range s.exdates {
+		if ex.Equal(t) {
+			return true
+		}
+		if s.allDay {
+			y1, m1, d1 := ex.Date()
+			y2, m2, d2 := t.Date()
+			if y1 == y2 && m1 == m2 && d1 == d2 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// recurrenceIterator yields the start times of a recurrence set in order.
+// DTSTART is always the first occurrence; the remaining ones are produced
+// one period (day, week, month, ...) at a time following RFC 5545 3.3.10.
+type recurrenceIterator struct {
+	rule     Recurrence
+	dtstart  time.Time
+	interval int
+	period   int
+	pending  []time.Time
+	emitted  int
+	started  bool
+	done     bool
+}
+
+func newRecurrenceIterator(dtstart time.Time, rec *Recurrence) *recurrenceIterator {
 	interval := rec.Interval
-	if interval == 0 {
+	if interval <= 0 {
 		interval = 1
 	}
 
-	switch rec.Frequency {
-	case "DAILY":
-		return current.AddDate(0, 0, interval)
-	case "WEEKLY":
-		return current.AddDate(0, 0, 7*interval)
+	return &recurrenceIterator{
+		rule:     withDefaultParts(*rec, dtstart),
+		dtstart:  dtstart,
+		interval: interval,
+	}
+}
+
+// withDefaultParts fills in the BY* parts implied by DTSTART when the rule
+// does not specify which days it selects, e.g. a plain WEEKLY rule repeats
+// on the weekday of DTSTART.
+func withDefaultParts(rule Recurrence, dtstart time.Time) Recurrence {
+	if len(rule.ByWeekNo) > 0 || len(rule.ByYearDay) > 0 || len(rule.ByMonthDay) > 0 || len(rule.ByDay) > 0 {
+		return rule
+	}
+
+	switch rule.Frequency {
+	case "YEARLY":
+		if len(rule.ByMonth) == 0 {
+			rule.ByMonth = []int{int(dtstart.Month())}
+		}
+		rule.ByMonthDay = []int{dtstart.Day()}
 	case "MONTHLY":
-		return current.AddDate(0, interval, 0)
+		rule.ByMonthDay = []int{dtstart.Day()}
+	case "WEEKLY":
+		rule.ByDay = []WeekdayNum{{Weekday: dtstart.Weekday()}}
+	}
+
+	return rule
+}
+
+func (it *recurrenceIterator) next() (time.Time, bool) {
+	if it.done {
+		return time.Time{}, false
+	}
+
+	// Check COUNT condition
+	if it.rule.Count != nil && it.emitted >= *it.rule.Count {
+		it.done = true
+		return time.Time{}, false
+	}
+
+	var current time.Time
+	if !it.started {
+		it.started = true
+		current = it.dtstart
+	} else {
+		empty := 0
+		for len(it.pending) == 0 {
+			if empty >= maxEmptyPeriods {
+				it.done = true
+				return time.Time{}, false
+			}
+			it.pending = it.periodOccurrences(it.period)
+			if len(it.pending) == 0 {
+				it.period = it.nextCandidatePeriod(it.period)
+			} else {
+				it.period++
+			}
+			empty++
+		}
+		current = it.pending[0]
+		it.pending = it.pending[1:]
+	}
+
+	// Check UNTIL condition
+	if it.rule.Until != nil && current.After(*it.rule.Until) {
+		it.done = true
+		return time.Time{}, false
+	}
+
+	it.emitted++
+	return current, true
+}
+
+// nextCandidatePeriod returns the period after the empty n-th one that may
+// hold an occurrence. For sub-daily rules it is the first period past the
+// day, hour or minute of the n-th one when the rule excludes it, so sparse
+// rules such as FREQ=MINUTELY;BYMONTHDAY=1 skip ahead a day at a time.
+func (it *recurrenceIterator) nextCandidatePeriod(n int) int {
+	var unit time.Duration
+	switch it.rule.Frequency {
+	case "HOURLY":
+		unit = time.Hour
+	case "MINUTELY":
+		unit = time.Minute
+	case "SECONDLY":
+		unit = time.Second
+	default:
+		return n + 1
+	}
+
+	step := time.Duration(it.interval) * unit
+	anchor := it.dtstart.Add(time.Duration(n) * step)
+	y, m, d := anchor.Date()
+	loc := it.dtstart.Location()
+
+	var target time.Time
+	switch {
+	case !it.rule.matchesDay(time.Date(y, m, d, 0, 0, 0, 0, loc)):
+		target = time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+	case len(it.rule.ByHour) > 0 && !containsInt(it.rule.ByHour, anchor.Hour()):
+		target = time.Date(y, m, d, anchor.Hour()+1, 0, 0, 0, loc)
+	case unit < time.Hour && len(it.rule.ByMinute) > 0 && !containsInt(it.rule.ByMinute, anchor.Minute()):
+		target = time.Date(y, m, d, anchor.Hour(), anchor.Minute()+1, 0, 0, loc)
+	default:
+		return n + 1
+	}
+
+	// The first period whose anchor is at or after target
+	next := int((target.Sub(it.dtstart) + step - 1) / step)
+	return max(next, n+1)
+}
+
+// periodOccurrences returns the sorted occurrences after DTSTART that fall
+// into the n-th period of the rule.
+func (it *recurrenceIterator) periodOccurrences(n int) []time.Time {
+	rule := &it.rule
+	loc := it.dtstart.Location()
+	y, m, d := it.dtstart.Date()
+	step := n * it.interval
+
+	var first, last time.Time // day range of the period, inclusive
+	periodYear := 0
+	subDaily := false
+	var anchor time.Time
+
+	switch rule.Frequency {
 	case "YEARLY":
-		return current.AddDate(interval, 0, 0)
+		periodYear = y + step
+		first = time.Date(periodYear, time.January, 1, 0, 0, 0, 0, loc)
+		last = time.Date(periodYear, time.December, 31, 0, 0, 0, 0, loc)
+		if len(rule.ByWeekNo) > 0 {
+			// Week 1 may begin in December and the last week may end in January
+			first = first.AddDate(0, 0, -6)
+			last = last.AddDate(0, 0, 6)
+		}
+	case "MONTHLY":
+		first = time.Date(y, m+time.Month(step), 1, 0, 0, 0, 0, loc)
+		last = first.AddDate(0, 1, -1)
+	case "WEEKLY":
+		first = startOfWeek(time.Date(y, m, d, 0, 0, 0, 0, loc), rule.weekStart()).AddDate(0, 0, 7*step)
+		last = first.AddDate(0, 0, 6)
+	case "HOURLY":
+		anchor = it.dtstart.Add(time.Duration(step) * time.Hour)
+		subDaily = true
+	case "MINUTELY":
+		anchor = it.dtstart.Add(time.Duration(step) * time.Minute)
+		subDaily = true
+	case "SECONDLY":
+		anchor = it.dtstart.Add(time.Duration(step) * time.Second)
+		subDaily = true
+	default: // DAILY
+		first = time.Date(y, m, d+step, 0, 0, 0, 0, loc)
+		last = first
+	}
+
+	hours := orDefault(rule.ByHour, it.dtstart.Hour())
+	minutes := orDefault(rule.ByMinute, it.dtstart.Minute())
+	seconds := orDefault(rule.BySecond, it.dtstart.Second())
+
+	if subDaily {
+		// Rule parts at or above the frequency limit the single candidate
+		// of the period instead of expanding it
+		first = time.Date(anchor.Year(), anchor.Month(), anchor.Day(), 0, 0, 0, 0, loc)
+		last = first
+		hours = limitTo(rule.ByHour, anchor.Hour())
+		if rule.Frequency != "HOURLY" {
+			minutes = limitTo(rule.ByMinute, anchor.Minute())
+		}
+		if rule.Frequency == "SECONDLY" {
+			seconds = limitTo(rule.BySecond, anchor.Second())
+		}
+	}
+
+	var set []time.Time
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if !rule.matchesDay(day) {
+			continue
+		}
+		if periodYear != 0 && len(rule.ByWeekNo) > 0 {
+			if wy, _ := weekNumber(day, rule.weekStart()); wy != periodYear {
+				continue
+			}
+		}
+		for _, hour := range hours {
+			for _, minute := range minutes {
+				for _, second := range seconds {
+					set = append(set, time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, it.dtstart.Nanosecond(), loc))
+				}
+			}
+		}
+	}
+
+	sort.Slice(set, func(i, j int) bool { return set[i].Before(set[j]) })
+
+	if len(rule.BySetPos) > 0 {
+		set = selectSetPos(set, rule.BySetPos)
+	}
+
+	var occurrences []time.Time
+	for _, t := range set {
+		if !t.After(it.dtstart) {
+			continue
+		}
+		if len(occurrences) > 0 && occurrences[len(occurrences)-1].Equal(t) {
+			continue
+		}
+		occurrences = append(occurrences, t)
+	}
+
+	return occurrences
+}
+
+// matchesDay applies the day-level BY* parts to a candidate day
+func (r *Recurrence) matchesDay(day time.Time) bool {
+	if len(r.ByMonth) > 0 && !containsInt(r.ByMonth, int(day.Month())) {
+		return false
+	}
+
+	if len(r.ByWeekNo) > 0 {
+		wy, week := weekNumber(day, r.weekStart())
+		if !matchesOrdinal(r.ByWeekNo, week, weeksInYear(wy, r.weekStart())) {
+			return false
+		}
+	}
+
+	if len(r.ByYearDay) > 0 && !matchesOrdinal(r.ByYearDay, day.YearDay(), daysInYear(day.Year())) {
+		return false
+	}
+
+	if len(r.ByMonthDay) > 0 && !matchesOrdinal(r.ByMonthDay, day.Day(), daysInMonth(day.Year(), day.Month())) {
+		return false
+	}
+
+	if len(r.ByDay) > 0 {
+		matched := false
+		for _, wd := range r.ByDay {
+			if r.matchesWeekday(day, wd) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesWeekday checks a BYDAY entry. Ordinals count within the month for
+// MONTHLY rules (or YEARLY rules with BYMONTH) and within the year for other
+// YEARLY rules; they are ignored elsewhere.
+func (r *Recurrence) matchesWeekday(day time.Time, wd WeekdayNum) bool {
+	if day.Weekday() != wd.Weekday {
+		return false
+	}
+	if wd.N == 0 {
+		return true
+	}
+
+	switch {
+	case r.Frequency == "MONTHLY" || (r.Frequency == "YEARLY" && len(r.ByMonth) > 0):
+		return nthWeekdayMatches(day.Day(), daysInMonth(day.Year(), day.Month()), wd.N)
+	case r.Frequency == "YEARLY" && len(r.ByWeekNo) == 0:
+		return nthWeekdayMatches(day.YearDay(), daysInYear(day.Year()), wd.N)
 	default:
-		return current.AddDate(0, 0, interval)
+		return true
+	}
+}
+
+func (r *Recurrence) weekStart() time.Weekday {
+	if r.WeekStart != nil {
+		return *r.WeekStart
+	}
+	return time.Monday
+}
+
+// nthWeekdayMatches reports whether the day at pos (1-based) of a span of
+// total days is the n-th (or -n-th from the end) of its weekday
+func nthWeekdayMatches(pos, total, n int) bool {
+	if n > 0 {
+		return (pos-1)/7+1 == n
+	}
+	return (total-pos)/7+1 == -n
+}
+
+// matchesOrdinal reports whether pos (1-based) of a span of total matches
+// any of values, where negative values count back from the end
+func matchesOrdinal(values []int, pos, total int) bool {
+	for _, v := range values {
+		if v == pos || (v < 0 && total+v+1 == pos) {
+			return true
+		}
+	}
+	return false
+}
+
+// selectSetPos picks the BYSETPOS entries from a sorted period set
+func selectSetPos(set []time.Time, positions []int) []time.Time {
+	var selected []time.Time
+	for _, pos := range positions {
+		idx := pos - 1
+		if pos < 0 {
+			idx = len(set) + pos
+		}
+		if idx >= 0 && idx < len(set) {
+			selected = append(selected, set[idx])
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool { return selected[i].Before(selected[j]) })
+	return selected
+}
+
+func startOfWeek(day time.Time, weekStart time.Weekday) time.Time {
+	offset := (int(day.Weekday()) - int(weekStart) + 7) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// firstWeekStart returns the first day of week 1 of year: the first week
+// with at least four days in that year
+func firstWeekStart(year int, weekStart time.Weekday, loc *time.Location) time.Time {
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	start := startOfWeek(jan1, weekStart)
+	if jan1.Sub(start) > 3*24*time.Hour {
+		start = start.AddDate(0, 0, 7)
+	}
+	return start
+}
+
+// weekNumber returns the week-numbering year and week of day
+func weekNumber(day time.Time, weekStart time.Weekday) (int, int) {
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	year := day.Year()
+	start := firstWeekStart(year, weekStart, day.Location())
+	if day.Before(start) {
+		year--
+		start = firstWeekStart(year, weekStart, day.Location())
+	} else if next := firstWeekStart(year+1, weekStart, day.Location()); !day.Before(next) {
+		year++
+		start = next
+	}
+	return year, daysBetween(start, day)/7 + 1
+}
+
+func weeksInYear(year int, weekStart time.Weekday) int {
+	start := firstWeekStart(year, weekStart, time.UTC)
+	next := firstWeekStart(year+1, weekStart, time.UTC)
+	return daysBetween(start, next) / 7
+}
+
+// daysBetween counts calendar days, ignoring DST shifts
+func daysBetween(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func daysInYear(year int) int {
+	return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+func orDefault(values []int, def int) []int {
+	if len(values) == 0 {
+		return []int{def}
+	}
+	sorted := append([]int(nil), values...)
+	sort.Ints(sorted)
+	return sorted
+}
+
+func limitTo(values []int, v int) []int {
+	if len(values) > 0 && !containsInt(values, v) {
+		return nil
+	}
+	return []int{v}
+}
+
+func containsInt(values []int, v int) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
 	}
+	return false
 }
@@ -320,3 +320,629 @@ func TestExpandRecurrenceWithLimit(t *testing.T) {
 		}
 	})
 }
+
+func TestExpandRecurrence_ByDay(t *testing.T) {
+	// Every Tuesday and Thursday, starting on Tuesday 2025-09-02
+	start := time.Date(2025, 9, 2, 9, 0, 0, 0, time.UTC)
+	count := 6
+	event := Event{
+		UID:     "tue-thu",
+		Summary: "Gym",
+		Start:   start,
+		End:     start.Add(time.Hour),
+		Recurrence: &Recurrence{
+			Frequency: "WEEKLY",
+			Interval:  1,
+			Count:     &count,
+			ByDay:     []WeekdayNum{{Weekday: time.Tuesday}, {Weekday: time.Thursday}},
+		},
+	}
+
+	instances := ExpandRecurrence(event, start, start.AddDate(1, 0, 0))
+
+	expectedDays := []int{2, 4, 9, 11, 16, 18}
+	if len(instances) != len(expectedDays) {
+		t.Fatalf("Expected %d instances, got %d", len(expectedDays), len(instances))
+	}
+	for i, instance := range instances {
+		if instance.Start.Day() != expectedDays[i] {
+			t.Errorf("Instance %d: expected day %d, got %d", i, expectedDays[i], instance.Start.Day())
+		}
+		if instance.Start.Hour() != 9 {
+			t.Errorf("Instance %d: expected hour 9, got %d", i, instance.Start.Hour())
+		}
+	}
+}
+
+func TestExpandRecurrence_MonthlyOrdinalWeekday(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    time.Time
+		rule     Recurrence
+		expected []time.Time
+	}{
+		{
+			name:  "first Monday of the month",
+			start: time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+			rule: Recurrence{
+				Frequency: "MONTHLY",
+				ByDay:     []WeekdayNum{{N: 1, Weekday: time.Monday}},
+			},
+			expected: []time.Time{
+				time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+				time.Date(2025, 10, 6, 10, 0, 0, 0, time.UTC),
+				time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC),
+				time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "last Friday of the month",
+			start: time.Date(2025, 9, 26, 16, 0, 0, 0, time.UTC),
+			rule: Recurrence{
+				Frequency: "MONTHLY",
+				ByDay:     []WeekdayNum{{N: -1, Weekday: time.Friday}},
+			},
+			expected: []time.Time{
+				time.Date(2025, 9, 26, 16, 0, 0, 0, time.UTC),
+				time.Date(2025, 10, 31, 16, 0, 0, 0, time.UTC),
+				time.Date(2025, 11, 28, 16, 0, 0, 0, time.UTC),
+				time.Date(2025, 12, 26, 16, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "last weekday of the month",
+			start: time.Date(2025, 9, 30, 17, 0, 0, 0, time.UTC),
+			rule: Recurrence{
+				Frequency: "MONTHLY",
+				ByDay: []WeekdayNum{
+					{Weekday: time.Monday}, {Weekday: time.Tuesday}, {Weekday: time.Wednesday},
+					{Weekday: time.Thursday}, {Weekday: time.Friday},
+				},
+				BySetPos: []int{-1},
+			},
+			expected: []time.Time{
+				time.Date(2025, 9, 30, 17, 0, 0, 0, time.UTC),
+				time.Date(2025, 10, 31, 17, 0, 0, 0, time.UTC),
+				time.Date(2025, 11, 28, 17, 0, 0, 0, time.UTC),
+				time.Date(2025, 12, 31, 17, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "friday the 13th",
+			start: time.Date(2026, 2, 13, 20, 0, 0, 0, time.UTC),
+			rule: Recurrence{
+				Frequency:  "MONTHLY",
+				ByDay:      []WeekdayNum{{Weekday: time.Friday}},
+				ByMonthDay: []int{13},
+			},
+			expected: []time.Time{
+				time.Date(2026, 2, 13, 20, 0, 0, 0, time.UTC),
+				time.Date(2026, 3, 13, 20, 0, 0, 0, time.UTC),
+				time.Date(2026, 11, 13, 20, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count := len(tt.expected)
+			rule := tt.rule
+			rule.Count = &count
+			event := Event{UID: "ordinal", Start: tt.start, End: tt.start.Add(time.Hour), Recurrence: &rule}
+
+			instances := ExpandRecurrence(event, tt.start, tt.start.AddDate(2, 0, 0))
+
+			if len(instances) != len(tt.expected) {
+				t.Fatalf("Expected %d instances, got %d", len(tt.expected), len(instances))
+			}
+			for i, instance := range instances {
+				if !instance.Start.Equal(tt.expected[i]) {
+					t.Errorf("Instance %d: expected %v, got %v", i, tt.expected[i], instance.Start)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandRecurrence_MonthlySkipsShortMonths(t *testing.T) {
+	start := time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+	event := Event{
+		UID:   "month-end",
+		Start: start,
+		End:   start.Add(time.Hour),
+		Recurrence: &Recurrence{
+			Frequency: "MONTHLY",
+			Interval:  1,
+		},
+	}
+
+	instances := ExpandRecurrence(event, start, time.Date(2025, 6, 30, 23, 59, 59, 0, time.UTC))
+
+	expectedMonths := []time.Month{time.January, time.March, time.May}
+	if len(instances) != len(expectedMonths) {
+		t.Fatalf("Expected %d instances, got %d", len(expectedMonths), len(instances))
+	}
+	for i, instance := range instances {
+		if instance.Start.Month() != expectedMonths[i] || instance.Start.Day() != 31 {
+			t.Errorf("Instance %d: expected %v 31, got %v", i, expectedMonths[i], instance.Start)
+		}
+	}
+}
+
+func TestExpandRecurrence_YearlyByMonth(t *testing.T) {
+	// Second Sunday of May (Mother's Day) every year
+	start := time.Date(2025, 5, 11, 12, 0, 0, 0, time.UTC)
+	event := Event{
+		UID:   "mothers-day",
+		Start: start,
+		End:   start.Add(time.Hour),
+		Recurrence: &Recurrence{
+			Frequency: "YEARLY",
+			ByMonth:   []int{5},
+			ByDay:     []WeekdayNum{{N: 2, Weekday: time.Sunday}},
+		},
+	}
+
+	instances := ExpandRecurrence(event, start, time.Date(2027, 12, 31, 0, 0, 0, 0, time.UTC))
+
+	expected := []time.Time{
+		time.Date(2025, 5, 11, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC),
+		time.Date(2027, 5, 9, 12, 0, 0, 0, time.UTC),
+	}
+	if len(instances) != len(expected) {
+		t.Fatalf("Expected %d instances, got %d", len(expected), len(instances))
+	}
+	for i, instance := range instances {
+		if !instance.Start.Equal(expected[i]) {
+			t.Errorf("Instance %d: expected %v, got %v", i, expected[i], instance.Start)
+		}
+	}
+}
+
+func TestExpandRecurrence_YearlyByWeekNo(t *testing.T) {
+	// Monday of ISO week 20 (RFC 5545 example)
+	start := time.Date(1997, 5, 12, 9, 0, 0, 0, time.UTC)
+	event := Event{
+		UID:   "week-20",
+		Start: start,
+		End:   start.Add(time.Hour),
+		Recurrence: &Recurrence{
+			Frequency: "YEARLY",
+			ByWeekNo:  []int{20},
+			ByDay:     []WeekdayNum{{Weekday: time.Monday}},
+		},
+	}
+
+	instances := ExpandRecurrence(event, start, time.Date(1999, 12, 31, 0, 0, 0, 0, time.UTC))
+
+	expected := []time.Time{
+		time.Date(1997, 5, 12, 9, 0, 0, 0, time.UTC),
+		time.Date(1998, 5, 11, 9, 0, 0, 0, time.UTC),
+		time.Date(1999, 5, 17, 9, 0, 0, 0, time.UTC),
+	}
+	if len(instances) != len(expected) {
+		t.Fatalf("Expected %d instances, got %d", len(expected), len(instances))
+	}
+	for i, instance := range instances {
+		if !instance.Start.Equal(expected[i]) {
+			t.Errorf("Instance %d: expected %v, got %v", i, expected[i], instance.Start)
+		}
+	}
+}
+
+func TestExpandRecurrence_WeekStart(t *testing.T) {
+	// RFC 5545: changing WKST changes the result of a bi-weekly BYDAY rule
+	start := time.Date(1997, 8, 5, 9, 0, 0, 0, time.UTC)
+	monday := time.Monday
+	sunday := time.Sunday
+
+	tests := []struct {
+		name         string
+		weekStart    *time.Weekday
+		expectedDays []int
+	}{
+		{name: "WKST=MO", weekStart: &monday, expectedDays: []int{5, 10, 19, 24}},
+		{name: "WKST=SU", weekStart: &sunday, expectedDays: []int{5, 17, 19, 31}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count := 4
+			event := Event{
+				UID:   "wkst",
+				Start: start,
+				End:   start.Add(time.Hour),
+				Recurrence: &Recurrence{
+					Frequency: "WEEKLY",
+					Interval:  2,
+					Count:     &count,
+					ByDay:     []WeekdayNum{{Weekday: time.Tuesday}, {Weekday: time.Sunday}},
+					WeekStart: tt.weekStart,
+				},
+			}
+
+			instances := ExpandRecurrence(event, start, start.AddDate(0, 2, 0))
+
+			if len(instances) != len(tt.expectedDays) {
+				t.Fatalf("Expected %d instances, got %d", len(tt.expectedDays), len(instances))
+			}
+			for i, instance := range instances {
+				if instance.Start.Day() != tt.expectedDays[i] {
+					t.Errorf("Instance %d: expected day %d, got %d", i, tt.expectedDays[i], instance.Start.Day())
+				}
+			}
+		})
+	}
+}
+
+func TestExpandRecurrence_ByHour(t *testing.T) {
+	start := time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)
+	count := 4
+	event := Event{
+		UID:   "twice-daily",
+		Start: start,
+		End:   start.Add(15 * time.Minute),
+		Recurrence: &Recurrence{
+			Frequency: "DAILY",
+			Count:     &count,
+			ByHour:    []int{9, 18},
+		},
+	}
+
+	instances := ExpandRecurrence(event, start, start.AddDate(0, 0, 7))
+
+	expected := []time.Time{
+		time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 9, 1, 18, 0, 0, 0, time.UTC),
+		time.Date(2025, 9, 2, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 9, 2, 18, 0, 0, 0, time.UTC),
+	}
+	if len(instances) != len(expected) {
+		t.Fatalf("Expected %d instances, got %d", len(expected), len(instances))
+	}
+	for i, instance := range instances {
+		if !instance.Start.Equal(expected[i]) {
+			t.Errorf("Instance %d: expected %v, got %v", i, expected[i], instance.Start)
+		}
+	}
+}
+
+func TestExpandRecurrence_ImpossibleRuleTerminates(t *testing.T) {
+	start := time.Date(2025, 1, 30, 9, 0, 0, 0, time.UTC)
+	event := Event{
+		UID:   "never",
+		Start: start,
+		End:   start.Add(time.Hour),
+		Recurrence: &Recurrence{
+			Frequency:  "YEARLY",
+			ByMonth:    []int{2},
+			ByMonthDay: []int{30},
+		},
+	}
+
+	instances := ExpandRecurrence(event, start, start.AddDate(100, 0, 0))
+
+	// Only DTSTART itself is an occurrence
+	if len(instances) != 1 {
+		t.Errorf("Expected 1 instance, got %d", len(instances))
+	}
+}
+
+func TestExpandRecurrence_ExDates(t *testing.T) {
+	start := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	count := 5
+	event := Event{
+		UID:     "weekly-with-exdate",
+		Summary: "Weekly sync",
+		Start:   start,
+		End:     start.Add(time.Hour),
+		Recurrence: &Recurrence{
+			Frequency: "WEEKLY",
+			Interval:  1,
+			Count:     &count,
+		},
+		ExDates: []time.Time{
+			time.Date(2025, 9, 8, 10, 0, 0, 0, time.UTC),
+			time.Date(2025, 9, 22, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	instances := ExpandRecurrence(event, start, start.AddDate(0, 3, 0))
+
+	// COUNT includes the excluded occurrences
+	expectedDays := []int{1, 15, 29}
+	if len(instances) != len(expectedDays) {
+		t.Fatalf("Expected %d instances, got %d", len(expectedDays), len(instances))
+	}
+	for i, instance := range instances {
+		if instance.Start.Day() != expectedDays[i] {
+			t.Errorf("Instance %d: expected day %d, got %d", i, expectedDays[i], instance.Start.Day())
+		}
+		if instance.ExDates != nil {
+			t.Error("Expanded instances should not carry EXDATE values")
+		}
+	}
+}
+
+func TestExpandRecurrence_AllDayExDateMatchesByDate(t *testing.T) {
+	start := time.Date(2025, 9, 1, 0, 0, 0, 0, time.Local)
+	count := 3
+	event := Event{
+		UID:    "all-day",
+		Start:  start,
+		End:    start.AddDate(0, 0, 1),
+		AllDay: true,
+		Recurrence: &Recurrence{
+			Frequency: "DAILY",
+			Count:     &count,
+		},
+		ExDates: []time.Time{time.Date(2025, 9, 2, 0, 0, 0, 0, time.Local)},
+	}
+
+	instances := ExpandRecurrence(event, start, start.AddDate(0, 1, 0))
+
+	if len(instances) != 2 {
+		t.Fatalf("Expected 2 instances, got %d", len(instances))
+	}
+	if instances[1].Start.Day() != 3 {
+		t.Errorf("Expected second instance on day 3, got %d", instances[1].Start.Day())
+	}
+}
+
+func TestExpandRecurrence_RDates(t *testing.T) {
+	start := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	count := 2
+	extra := time.Date(2025, 9, 4, 15, 0, 0, 0, time.UTC)
+
+	t.Run("with rule", func(t *testing.T) {
+		event := Event{
+			UID:   "rdate-rule",
+			Start: start,
+			End:   start.Add(time.Hour),
+			Recurrence: &Recurrence{
+				Frequency: "WEEKLY",
+				Count:     &count,
+			},
+			RDates: []time.Time{extra, start},
+		}
+
+		instances := ExpandRecurrence(event, start, start.AddDate(0, 1, 0))
+
+		expected := []time.Time{start, extra, start.AddDate(0, 0, 7)}
+		if len(instances) != len(expected) {
+			t.Fatalf("Expected %d instances, got %d", len(expected), len(instances))
+		}
+		for i, instance := range instances {
+			if !instance.Start.Equal(expected[i]) {
+				t.Errorf("Instance %d: expected %v, got %v", i, expected[i], instance.Start)
+			}
+			if instance.End.Sub(instance.Start) != time.Hour {
+				t.Errorf("Instance %d: expected 1h duration", i)
+			}
+		}
+	})
+
+	t.Run("without rule", func(t *testing.T) {
+		event := Event{
+			UID:    "rdate-only",
+			Start:  start,
+			End:    start.Add(time.Hour),
+			RDates: []time.Time{extra},
+		}
+
+		if !event.IsRecurring() {
+			t.Fatal("Expected event with RDATE to be recurring")
+		}
+
+		instances := ExpandRecurrence(event, start, start.AddDate(0, 1, 0))
+		if len(instances) != 2 {
+			t.Fatalf("Expected 2 instances, got %d", len(instances))
+		}
+		if !instances[1].Start.Equal(extra) {
+			t.Errorf("Expected RDATE instance at %v, got %v", extra, instances[1].Start)
+		}
+	})
+}
+
+func TestExpandRecurrence_Overrides(t *testing.T) {
+	start := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	count := 4
+	moved := time.Date(2025, 9, 8, 10, 0, 0, 0, time.UTC)
+	intoRange := time.Date(2025, 9, 22, 10, 0, 0, 0, time.UTC)
+	event := Event{
+		UID:      "series",
+		Summary:  "Planning",
+		Location: "Room 1",
+		Calendar: "work",
+		Start:    start,
+		End:      start.Add(time.Hour),
+		Recurrence: &Recurrence{
+			Frequency: "WEEKLY",
+			Count:     &count,
+		},
+		Overrides: []Event{
+			{
+				UID:          "series",
+				Summary:      "Planning",
+				Location:     "Room 2",
+				Start:        time.Date(2025, 9, 9, 14, 0, 0, 0, time.UTC),
+				End:          time.Date(2025, 9, 9, 15, 0, 0, 0, time.UTC),
+				RecurrenceID: &moved,
+			},
+			{
+				UID:          "series",
+				Summary:      "Planning (early)",
+				Start:        time.Date(2025, 9, 12, 9, 0, 0, 0, time.UTC),
+				End:          time.Date(2025, 9, 12, 10, 0, 0, 0, time.UTC),
+				RecurrenceID: &intoRange,
+			},
+		},
+	}
+
+	t.Run("substitutes overridden instance", func(t *testing.T) {
+		instances := ExpandRecurrence(event, start, start.AddDate(0, 1, 0))
+
+		if len(instances) != 4 {
+			t.Fatalf("Expected 4 instances, got %d", len(instances))
+		}
+		second := instances[1]
+		if second.Location != "Room 2" || second.Start.Day() != 9 || second.Start.Hour() != 14 {
+			t.Errorf("Expected override on Sept 9 at 14:00 in Room 2, got %+v", second)
+		}
+		if second.Calendar != "work" {
+			t.Errorf("Expected override to inherit calendar, got %q", second.Calendar)
+		}
+		for i := 1; i < len(instances); i++ {
+			if instances[i].Start.Before(instances[i-1].Start) {
+				t.Errorf("Instances are not sorted: %v before %v", instances[i].Start, instances[i-1].Start)
+			}
+		}
+	})
+
+	t.Run("includes override moved into range", func(t *testing.T) {
+		rangeStart := time.Date(2025, 9, 12, 0, 0, 0, 0, time.UTC)
+		rangeEnd := time.Date(2025, 9, 13, 0, 0, 0, 0, time.UTC)
+
+		instances := ExpandRecurrence(event, rangeStart, rangeEnd)
+
+		if len(instances) != 1 || instances[0].Summary != "Planning (early)" {
+			t.Fatalf("Expected only the moved override, got %+v", instances)
+		}
+	})
+
+	t.Run("excluded override is dropped", func(t *testing.T) {
+		cancelled := event
+		cancelled.ExDates = []time.Time{moved}
+
+		instances := ExpandRecurrence(cancelled, start, start.AddDate(0, 1, 0))
+
+		if len(instances) != 3 {
+			t.Fatalf("Expected 3 instances, got %d", len(instances))
+		}
+		for _, instance := range instances {
+			if instance.Location == "Room 2" {
+				t.Error("Excluded occurrence should not be replaced by its override")
+			}
+		}
+	})
+}
+
+func TestExpandRecurrence_KeepsWallClockAcrossDST(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	count := 3
+	event := Event{
+		UID:        "dst",
+		Summary:    "Standup",
+		Start:      time.Date(2025, 3, 6, 9, 0, 0, 0, newYork),
+		End:        time.Date(2025, 3, 6, 9, 15, 0, 0, newYork),
+		Recurrence: &Recurrence{Frequency: "WEEKLY", Interval: 1, Count: &count},
+	}
+
+	instances := ExpandRecurrence(event, event.Start, event.Start.AddDate(0, 1, 0))
+
+	if len(instances) != 3 {
+		t.Fatalf("Expected 3 instances, got %d", len(instances))
+	}
+	for _, instance := range instances {
+		if instance.Start.Hour() != 9 || instance.Start.Location() != newYork {
+			t.Errorf("Expected 09:00 America/New_York, got %v", instance.Start)
+		}
+		if instance.End.Sub(instance.Start) != 15*time.Minute {
+			t.Errorf("Expected 15m duration, got %v", instance.End.Sub(instance.Start))
+		}
+	}
+	if _, offset := instances[0].Start.Zone(); offset != -5*3600 {
+		t.Errorf("Expected EST before the transition, got offset %d", offset)
+	}
+	if _, offset := instances[1].Start.Zone(); offset != -4*3600 {
+		t.Errorf("Expected EDT after the transition, got offset %d", offset)
+	}
+}
+
+func TestExpandRecurrence_OccurrencesKeepSeries(t *testing.T) {
+	start := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	rule := &Recurrence{Frequency: "DAILY"}
+	moved := start.AddDate(0, 0, 1)
+	event := Event{
+		UID:        "daily-event",
+		Start:      start,
+		End:        start.Add(time.Hour),
+		Recurrence: rule,
+		Overrides: []Event{
+			{UID: "daily-event", Start: moved.Add(2 * time.Hour), End: moved.Add(3 * time.Hour), RecurrenceID: &moved},
+		},
+	}
+
+	instances := ExpandRecurrence(event, start, start.AddDate(0, 0, 2))
+	if len(instances) != 3 {
+		t.Fatalf("Expected 3 instances, got %d", len(instances))
+	}
+	for i, instance := range instances {
+		original := start.AddDate(0, 0, i)
+		if instance.RecurrenceID == nil || !instance.RecurrenceID.Equal(original) {
+			t.Errorf("Instance %d: expected RecurrenceID %v, got %v", i, original, instance.RecurrenceID)
+		}
+		if instance.SeriesRule != rule || instance.Recurrence != nil {
+			t.Errorf("Instance %d: expected the series rule and no recurrence, got %+v", i, instance)
+		}
+	}
+}
+
+func TestExpandRecurrence_SparseSubDailyRules(t *testing.T) {
+	start := time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		rule     Recurrence
+		expected []time.Time
+	}{
+		{
+			name: "minutely on the first of the month",
+			rule: Recurrence{Frequency: "MINUTELY", ByMonthDay: []int{1}, ByHour: []int{9}, ByMinute: []int{0, 30}},
+			expected: []time.Time{
+				time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, 2, 1, 9, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "secondly once a year",
+			rule: Recurrence{Frequency: "SECONDLY", ByMonth: []int{3}, ByMonthDay: []int{15}, ByHour: []int{12}, ByMinute: []int{0}, BySecond: []int{0}},
+			expected: []time.Time{
+				time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "hourly on leap days",
+			rule: Recurrence{Frequency: "HOURLY", ByMonth: []int{2}, ByMonthDay: []int{29}, ByHour: []int{8}},
+			expected: []time.Time{
+				time.Date(2028, 2, 29, 8, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := tt.rule
+			event := Event{UID: "sparse", Start: start, End: start.Add(time.Minute), Recurrence: &rule}
+
+			instances := ExpandRecurrence(event, start.Add(time.Hour), time.Date(2028, 12, 31, 0, 0, 0, 0, time.UTC))
+
+			var got []time.Time
+			for _, instance := range instances {
+				got = append(got, instance.Start)
+			}
+			if len(got) < len(tt.expected) {
+				t.Fatalf("expected at least %d instances, got %v", len(tt.expected), got)
+			}
+			for i, expected := range tt.expected {
+				if !got[i].Equal(expected) {
+					t.Errorf("instance %d: expected %v, got %v", i, expected, got[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Todo statuses, as in the VTODO STATUS property
+const (
+	TodoNeedsAction = "NEEDS-ACTION"
+	TodoInProcess   = "IN-PROCESS"
+	TodoCompleted   = "COMPLETED"
+	TodoCancelled   = "CANCELLED"
+)
+
+// Todo is a VTODO task
+type Todo struct {
+	UID             string
+	Summary         string
+	Description     string
+	Start           *time.Time // DTSTART
+	Due             *time.Time
+	AllDay          bool // DTSTART and DUE are dates
+	Floating        bool // DTSTART and DUE are bound to no zone
+	Priority        int  // 1 (highest) to 9 (lowest), 0 when undefined
+	Status          string
+	PercentComplete int
+	Completed       *time.Time
+	Recurrence      *Recurrence
+	Calendar        string
+
+	// Source is the iCalendar component the todo was parsed from. Writing
+	// the todo back patches it, keeping properties calcli doesn't model.
+	Source string
+
+	// SourceZones are the VTIMEZONE components Source refers to
+	SourceZones []string
+}
+
+// IsDone reports whether the todo is completed or cancelled
+func (t Todo) IsDone() bool {
+	return t.Status == TodoCompleted || t.Status == TodoCancelled
+}
+
+// IsOverdue reports whether an open todo was due before now. Todos due on
+// a date are overdue the day after.
+func (t Todo) IsOverdue(now time.Time) bool {
+	if t.IsDone() || t.Due == nil {
+		return false
+	}
+	if t.AllDay {
+		return !now.Before(startOfDay(t.Due.AddDate(0, 0, 1)))
+	}
+	return t.Due.Before(now)
+}
+
+// Complete returns the todo marked done at now. A recurring todo moves on
+// to its next occurrence instead, with the completed one counted against
+// COUNT; after its last occurrence it is completed like any other.
+func (t Todo) Complete(now time.Time) Todo {
+	if next, ok := t.nextOccurrence(); ok {
+		anchor := t.anchor()
+		shift := func(v *time.Time) *time.Time {
+			if v == nil {
+				return nil
+			}
+			var moved time.Time
+			if t.AllDay {
+				moved = v.AddDate(0, 0, daysBetween(*anchor, next))
+			} else {
+				moved = v.Add(next.Sub(*anchor))
+			}
+			return &moved
+		}
+
+		t.Start = shift(t.Start)
+		t.Due = shift(t.Due)
+		if t.Recurrence.Count != nil {
+			rule := *t.Recurrence
+			count := *rule.Count - 1
+			rule.Count = &count
+			t.Recurrence = &rule
+		}
+		t.Status = TodoNeedsAction
+		t.PercentComplete = 0
+		t.Completed = nil
+		return t
+	}
+
+	t.Status = TodoCompleted
+	t.PercentComplete = 100
+	t.Completed = &now
+	return t
+}
+
+// anchor returns the time the recurrence of the todo is counted from: its
+// due time, or its start when it has none
+func (t Todo) anchor() *time.Time {
+	if t.Due != nil {
+		return t.Due
+	}
+	return t.Start
+}
+
+// nextOccurrence returns the occurrence following the current one of a
+// recurring todo
+func (t Todo) nextOccurrence() (time.Time, bool) {
+	anchor := t.anchor()
+	if t.Recurrence == nil || anchor == nil {
+		return time.Time{}, false
+	}
+
+	set := newRecurrenceSet(Event{Start: *anchor, AllDay: t.AllDay, Recurrence: t.Recurrence})
+	for {
+		next, ok := set.next()
+		if !ok {
+			return time.Time{}, false
+		}
+		if next.After(*anchor) {
+			return next, true
+		}
+	}
+}
+
+// SortTodos orders todos for display: open todos first, then by due time
+// with undated todos last, then by priority with undefined priority last
+func SortTodos(todos []Todo) {
+	sort.SliceStable(todos, func(i, j int) bool {
+		a, b := todos[i], todos[j]
+		if a.IsDone() != b.IsDone() {
+			return !a.IsDone()
+		}
+		if (a.Due == nil) != (b.Due == nil) {
+			return a.Due != nil
+		}
+		if a.Due != nil && !a.Due.Equal(*b.Due) {
+			return a.Due.Before(*b.Due)
+		}
+		if a.Priority != b.Priority {
+			return priorityRank(a.Priority) < priorityRank(b.Priority)
+		}
+		return strings.ToLower(a.Summary) < strings.ToLower(b.Summary)
+	})
+}
+
+// priorityRank ranks undefined priority (0) below the lowest priority (9)
+func priorityRank(priority int) int {
+	if priority == 0 {
+		return 10
+	}
+	return priority
+}
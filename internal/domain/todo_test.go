@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTodo_Complete(t *testing.T) {
+	now := time.Date(2025, 9, 3, 18, 0, 0, 0, time.UTC)
+	due := time.Date(2025, 9, 3, 17, 0, 0, 0, time.UTC)
+	start := time.Date(2025, 9, 3, 9, 0, 0, 0, time.UTC)
+	nextDay := due.AddDate(0, 0, 1)
+	nextWeek := due.AddDate(0, 0, 7)
+	nextWeekStart := start.AddDate(0, 0, 7)
+	count, one := 2, 1
+
+	tests := []struct {
+		name          string
+		todo          Todo
+		expectStatus  string
+		expectDue     *time.Time
+		expectStart   *time.Time
+		expectCount   *int
+		expectStamped bool
+	}{
+		{
+			name:          "single todo",
+			todo:          Todo{Due: &due, Status: TodoInProcess, PercentComplete: 50},
+			expectStatus:  TodoCompleted,
+			expectDue:     &due,
+			expectStamped: true,
+		},
+		{
+			name:         "weekly todo moves to next week",
+			todo:         Todo{Start: &start, Due: &due, Recurrence: &Recurrence{Frequency: "WEEKLY", Interval: 1}},
+			expectStatus: TodoNeedsAction,
+			expectDue:    &nextWeek,
+			expectStart:  &nextWeekStart,
+		},
+		{
+			name:         "count is used up",
+			todo:         Todo{Due: &due, Recurrence: &Recurrence{Frequency: "DAILY", Interval: 1, Count: &count}},
+			expectStatus: TodoNeedsAction,
+			expectDue:    &nextDay,
+			expectCount:  &one,
+		},
+		{
+			name:          "last occurrence",
+			todo:          Todo{Due: &due, Recurrence: &Recurrence{Frequency: "DAILY", Interval: 1, Count: &one}},
+			expectStatus:  TodoCompleted,
+			expectDue:     &due,
+			expectCount:   &one,
+			expectStamped: true,
+		},
+		{
+			name:          "recurring todo without dates",
+			todo:          Todo{Recurrence: &Recurrence{Frequency: "DAILY", Interval: 1}},
+			expectStatus:  TodoCompleted,
+			expectStamped: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.todo.Complete(now)
+
+			if got.Status != tt.expectStatus {
+				t.Errorf("expected status %s, got %s", tt.expectStatus, got.Status)
+			}
+			if !equalTimePtr(got.Due, tt.expectDue) {
+				t.Errorf("expected due %v, got %v", tt.expectDue, got.Due)
+			}
+			if !equalTimePtr(got.Start, tt.expectStart) {
+				t.Errorf("expected start %v, got %v", tt.expectStart, got.Start)
+			}
+			if tt.expectCount != nil && *got.Recurrence.Count != *tt.expectCount {
+				t.Errorf("expected count %d, got %d", *tt.expectCount, *got.Recurrence.Count)
+			}
+			if stamped := got.Completed != nil && got.Completed.Equal(now) && got.PercentComplete == 100; stamped != tt.expectStamped {
+				t.Errorf("expected completion stamp %v, got completed=%v percent=%d", tt.expectStamped, got.Completed, got.PercentComplete)
+			}
+		})
+	}
+
+	if count != 2 {
+		t.Error("Complete must not modify the original recurrence")
+	}
+}
+
+func TestSortTodos(t *testing.T) {
+	day := func(d int) *time.Time {
+		v := time.Date(2025, 9, d, 12, 0, 0, 0, time.UTC)
+		return &v
+	}
+	todos := []Todo{
+		{Summary: "done", Due: day(1), Status: TodoCompleted},
+		{Summary: "undated", Priority: 1},
+		{Summary: "later", Due: day(5)},
+		{Summary: "soon, no priority", Due: day(2)},
+		{Summary: "soon, urgent", Due: day(2), Priority: 1},
+		{Summary: "soon, low", Due: day(2), Priority: 9},
+	}
+
+	SortTodos(todos)
+
+	expected := []string{"soon, urgent", "soon, low", "soon, no priority", "later", "undated", "done"}
+	for i, todo := range todos {
+		if todo.Summary != expected[i] {
+			t.Errorf("position %d: expected %q, got %q", i, expected[i], todo.Summary)
+		}
+	}
+}
+
+func TestTodo_IsOverdue(t *testing.T) {
+	now := time.Date(2025, 9, 3, 12, 0, 0, 0, time.UTC)
+	earlier, later := now.Add(-time.Hour), now.Add(time.Hour)
+	today, yesterday := time.Date(2025, 9, 3, 0, 0, 0, 0, time.UTC), time.Date(2025, 9, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		todo     Todo
+		expected bool
+	}{
+		{name: "due earlier today", todo: Todo{Due: &earlier}, expected: true},
+		{name: "due later today", todo: Todo{Due: &later}},
+		{name: "due on today's date", todo: Todo{Due: &today, AllDay: true}},
+		{name: "due on yesterday's date", todo: Todo{Due: &yesterday, AllDay: true}, expected: true},
+		{name: "completed", todo: Todo{Due: &earlier, Status: TodoCompleted}},
+		{name: "undated", todo: Todo{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.todo.IsOverdue(now); got != tt.expected {
+				t.Errorf("IsOverdue() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func equalTimePtr(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -3,6 +3,9 @@ package ical
 import (
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/NaMinhyeok/calcli/internal/domain"
 
@@ -10,10 +13,96 @@ import (
 )
 
 func GenerateEvent(event domain.Event, w io.Writer) error {
+	return GenerateEvents([]domain.Event{event}, w)
+}
+
+// GenerateEvents writes events into a single VCALENDAR. The overrides of a
+// recurring event are written as additional VEVENTs sharing its UID.
+func GenerateEvents(events []domain.Event, w io.Writer) error {
+	return GenerateObjects(Objects{Events: events}, w)
+}
+
+// GenerateObjects writes events, todos and journal entries into a single
+// VCALENDAR
+func GenerateObjects(objects Objects, w io.Writer) error {
 	cal := ics.NewCalendar()
 	cal.SetMethod(ics.MethodPublish)
 
-	vevent := cal.AddEvent(event.UID)
+	// VTIMEZONE components precede the components that reference them.
+	// Zones parsed along with a component are written back as they were,
+	// and only the zones they don't cover are generated.
+	var sourceZones []string
+	var dated []domain.Event
+	for _, event := range objects.Events {
+		for _, e := range append([]domain.Event{event}, event.Overrides...) {
+			sourceZones = append(sourceZones, e.SourceZones...)
+			dated = append(dated, e)
+		}
+	}
+	for _, todo := range objects.Todos {
+		sourceZones = append(sourceZones, todo.SourceZones...)
+		if todo.Start != nil || todo.Due != nil {
+			dated = append(dated, todoDates(todo))
+		}
+	}
+	for _, journal := range objects.Journals {
+		sourceZones = append(sourceZones, journal.SourceZones...)
+		dated = append(dated, journalDates(journal))
+	}
+
+	seen := make(map[string]bool)
+	for _, source := range sourceZones {
+		if tzid := zoneID(source); !seen[tzid] {
+			seen[tzid] = true
+			cal.Components = append(cal.Components, &rawComponent{lines: unfoldLines(source)})
+		}
+	}
+	for _, e := range dated {
+		if e.AllDay || e.Floating {
+			continue
+		}
+		loc := e.Start.Location()
+		if name := zoneName(loc); name != "" && !seen[name] {
+			seen[name] = true
+			cal.AddVTimezone(buildTimezone(loc, e.Start.Year()))
+		}
+	}
+
+	for _, event := range objects.Events {
+		addEvent(cal, event)
+		for _, override := range event.Overrides {
+			override.UID = event.UID
+			addEvent(cal, override)
+		}
+	}
+
+	for _, todo := range objects.Todos {
+		addTodo(cal, todo)
+	}
+
+	for _, journal := range objects.Journals {
+		addJournal(cal, journal)
+	}
+
+	// Write to output
+	return cal.SerializeTo(w)
+}
+
+// addEvent adds event to cal. An event parsed from a file is written as its
+// source component with only the changed properties replaced, so everything
+// calcli doesn't model is kept as it was.
+func addEvent(cal *ics.Calendar, event domain.Event) {
+	vevent := newVEvent(event)
+	if lines, ok := patchSource(event, vevent); ok {
+		cal.Components = append(cal.Components, &rawComponent{lines: lines})
+		return
+	}
+	cal.AddVEvent(vevent)
+}
+
+// newVEvent builds a VEVENT holding the properties calcli models
+func newVEvent(event domain.Event) *ics.VEvent {
+	vevent := ics.NewEvent(event.UID)
 	vevent.SetSummary(event.Summary)
 
 	if event.Description != "" {
@@ -25,25 +114,152 @@ func GenerateEvent(event domain.Event, w io.Writer) error {
 	}
 
 	// Set times
-	if event.AllDay {
-		vevent.SetAllDayStartAt(event.Start)
-		vevent.SetAllDayEndAt(event.End)
-	} else {
-		vevent.SetStartAt(event.Start)
-		vevent.SetEndAt(event.End)
+	value, params := formatDateList([]time.Time{event.Start}, event)
+	vevent.SetProperty(ics.ComponentProperty(ics.PropertyDtstart), value, params...)
+	value, params = formatDateList([]time.Time{event.EndTime()}, event)
+	vevent.SetProperty(ics.ComponentProperty(ics.PropertyDtend), value, params...)
+
+	if event.RecurrenceID != nil {
+		value, params := formatDateList([]time.Time{*event.RecurrenceID}, event)
+		vevent.SetProperty(ics.ComponentProperty(ics.PropertyRecurrenceId), value, params...)
 	}
 
 	// Set recurrence rule if present
 	if event.Recurrence != nil {
-		rrule := buildRRULE(event.Recurrence)
+		rrule := FormatRRULE(event.Recurrence, event)
 		vevent.SetProperty(ics.ComponentProperty(ics.PropertyRrule), rrule)
 	}
 
-	// Write to output
-	return cal.SerializeTo(w)
+	if len(event.ExDates) > 0 {
+		value, params := formatDateList(event.ExDates, event)
+		vevent.AddProperty(ics.ComponentProperty(ics.PropertyExdate), value, params...)
+	}
+
+	if len(event.RDates) > 0 {
+		value, params := formatDateList(event.RDates, event)
+		vevent.AddProperty(ics.ComponentProperty(ics.PropertyRdate), value, params...)
+	}
+
+	if event.Organizer != nil {
+		vevent.SetProperty(ics.ComponentPropertyOrganizer, "mailto:"+event.Organizer.Email, attendeeParams(*event.Organizer)...)
+	}
+
+	for _, attendee := range event.Attendees {
+		vevent.AddProperty(ics.ComponentPropertyAttendee, "mailto:"+attendee.Email, attendeeParams(attendee)...)
+	}
+
+	for _, alarm := range event.Alarms {
+		addAlarm(vevent, alarm)
+	}
+
+	return vevent
+}
+
+// attendeeParams returns the parameters of an ORGANIZER or ATTENDEE
+func attendeeParams(attendee domain.Attendee) []ics.PropertyParameter {
+	var params []ics.PropertyParameter
+	if attendee.Name != "" {
+		params = append(params, ics.WithCN(attendee.Name))
+	}
+	if attendee.Role != "" {
+		params = append(params, ics.ParticipationRole(attendee.Role))
+	}
+	if attendee.Status != "" {
+		params = append(params, ics.ParticipationStatus(attendee.Status))
+	}
+	if attendee.RSVP {
+		params = append(params, &ics.KeyValues{Key: string(ics.ParameterRsvp), Value: []string{"TRUE"}})
+	}
+	return params
 }
 
-func buildRRULE(rec *domain.Recurrence) string {
+// addAlarm adds a VALARM with an absolute or relative TRIGGER
+func addAlarm(vevent *ics.VEvent, alarm domain.Alarm) {
+	valarm := vevent.AddAlarm()
+	valarm.SetAction(ics.Action(alarm.Action))
+
+	switch {
+	case alarm.At != nil:
+		valarm.SetTrigger(alarm.At.UTC().Format("20060102T150405Z"), ics.WithValue(string(ics.ValueDataTypeDateTime)))
+	case alarm.RelatedEnd:
+		valarm.SetTrigger(formatDuration(alarm.Trigger), &ics.KeyValues{Key: string(ics.ParameterRelated), Value: []string{"END"}})
+	default:
+		valarm.SetTrigger(formatDuration(alarm.Trigger))
+	}
+
+	if alarm.Description != "" {
+		valarm.SetProperty(ics.ComponentPropertyDescription, alarm.Description)
+	}
+}
+
+// formatDuration renders a dur-value such as "-PT15M" or "-P1D"
+func formatDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+
+	day := 24 * time.Hour
+	var b strings.Builder
+	b.WriteString(sign + "P")
+	if days := d / day; days > 0 {
+		fmt.Fprintf(&b, "%dD", days)
+		d -= days * day
+	}
+	if d == 0 && b.Len() > len(sign)+1 {
+		return b.String()
+	}
+
+	b.WriteString("T")
+	if h := d / time.Hour; h > 0 {
+		fmt.Fprintf(&b, "%dH", h)
+		d -= h * time.Hour
+	}
+	if m := d / time.Minute; m > 0 {
+		fmt.Fprintf(&b, "%dM", m)
+		d -= m * time.Minute
+	}
+	if s := d / time.Second; s > 0 || strings.HasSuffix(b.String(), "T") {
+		fmt.Fprintf(&b, "%dS", s)
+	}
+	return b.String()
+}
+
+// formatDateList renders DTSTART/DTEND/EXDATE/RDATE/RECURRENCE-ID values in
+// the value type and zone of the event: DATE for all-day events, floating
+// local time, local time with TZID, or UTC
+func formatDateList(dates []time.Time, event domain.Event) (string, []ics.PropertyParameter) {
+	loc := event.Start.Location()
+	tzid := zoneName(loc)
+
+	values := make([]string, len(dates))
+	for i, t := range dates {
+		switch {
+		case event.AllDay:
+			values[i] = t.Format("20060102")
+		case event.Floating:
+			values[i] = t.Format("20060102T150405")
+		case tzid != "":
+			values[i] = t.In(loc).Format("20060102T150405")
+		default:
+			values[i] = t.UTC().Format("20060102T150405Z")
+		}
+	}
+
+	var params []ics.PropertyParameter
+	switch {
+	case event.AllDay:
+		params = append(params, ics.WithValue(string(ics.ValueDataTypeDate)))
+	case !event.Floating && tzid != "":
+		params = append(params, ics.WithTZID(tzid))
+	}
+	return strings.Join(values, ","), params
+}
+
+// FormatRRULE renders rec as an RRULE value, or "" when rec is nil. UNTIL
+// takes the form of the DTSTART of series, as RFC 5545 requires: a DATE for
+// all-day series, a local time for floating ones and a UTC time otherwise.
+func FormatRRULE(rec *domain.Recurrence, series domain.Event) string {
 	if rec == nil {
 		return ""
 	}
@@ -59,8 +275,65 @@ func buildRRULE(rec *domain.Recurrence) string {
 	}
 
 	if rec.Until != nil {
-		rrule += fmt.Sprintf(";UNTIL=%s", rec.Until.Format("20060102T150405Z"))
+		until := rec.Until.In(series.Start.Location())
+		switch {
+		case series.AllDay:
+			rrule += ";UNTIL=" + until.Format("20060102")
+		case series.Floating:
+			rrule += ";UNTIL=" + until.Format("20060102T150405")
+		default:
+			rrule += ";UNTIL=" + until.UTC().Format("20060102T150405Z")
+		}
+	}
+
+	rrule += formatIntList("BYMONTH", rec.ByMonth)
+	rrule += formatIntList("BYWEEKNO", rec.ByWeekNo)
+	rrule += formatIntList("BYYEARDAY", rec.ByYearDay)
+	rrule += formatIntList("BYMONTHDAY", rec.ByMonthDay)
+
+	if len(rec.ByDay) > 0 {
+		days := make([]string, len(rec.ByDay))
+		for i, day := range rec.ByDay {
+			days[i] = formatWeekdayNum(day)
+		}
+		rrule += ";BYDAY=" + strings.Join(days, ",")
+	}
+
+	rrule += formatIntList("BYHOUR", rec.ByHour)
+	rrule += formatIntList("BYMINUTE", rec.ByMinute)
+	rrule += formatIntList("BYSECOND", rec.BySecond)
+	rrule += formatIntList("BYSETPOS", rec.BySetPos)
+
+	if rec.WeekStart != nil {
+		rrule += ";WKST=" + weekdayCode(*rec.WeekStart)
 	}
 
 	return rrule
 }
+
+func formatIntList(part string, values []int) string {
+	if len(values) == 0 {
+		return ""
+	}
+	items := make([]string, len(values))
+	for i, v := range values {
+		items[i] = strconv.Itoa(v)
+	}
+	return ";" + part + "=" + strings.Join(items, ",")
+}
+
+func formatWeekdayNum(day domain.WeekdayNum) string {
+	if day.N == 0 {
+		return weekdayCode(day.Weekday)
+	}
+	return strconv.Itoa(day.N) + weekdayCode(day.Weekday)
+}
+
+func weekdayCode(weekday time.Weekday) string {
+	for code, wd := range weekdayCodes {
+		if wd == weekday {
+			return code
+		}
+	}
+	return ""
+}
@@ -117,6 +117,7 @@ func TestBuildRRULE(t *testing.T) {
 	tests := []struct {
 		name       string
 		recurrence *domain.Recurrence
+		series     domain.Event
 		want       string
 	}{
 		{
@@ -158,6 +159,26 @@ func TestBuildRRULE(t *testing.T) {
 			},
 			want: "FREQ=MONTHLY;UNTIL=20251231T235959Z",
 		},
+		{
+			name: "until of an all-day series",
+			recurrence: &domain.Recurrence{
+				Frequency: "DAILY",
+				Interval:  1,
+				Until:     timePtr(time.Date(2025, 10, 10, 0, 0, 0, 0, time.FixedZone("CEST", 2*3600))),
+			},
+			series: domain.Event{Start: time.Date(2025, 10, 1, 0, 0, 0, 0, time.FixedZone("CEST", 2*3600)), AllDay: true},
+			want:   "FREQ=DAILY;UNTIL=20251010",
+		},
+		{
+			name: "until of a floating series",
+			recurrence: &domain.Recurrence{
+				Frequency: "WEEKLY",
+				Interval:  1,
+				Until:     timePtr(time.Date(2025, 10, 10, 9, 0, 0, 0, time.Local)),
+			},
+			series: domain.Event{Start: time.Date(2025, 10, 3, 9, 0, 0, 0, time.Local), Floating: true},
+			want:   "FREQ=WEEKLY;UNTIL=20251010T090000",
+		},
 		{
 			name: "complex rule",
 			recurrence: &domain.Recurrence{
@@ -167,14 +188,202 @@ func TestBuildRRULE(t *testing.T) {
 			},
 			want: "FREQ=WEEKLY;INTERVAL=2;COUNT=5",
 		},
+		{
+			name: "weekly on tuesday and thursday",
+			recurrence: &domain.Recurrence{
+				Frequency: "WEEKLY",
+				Interval:  1,
+				ByDay:     []domain.WeekdayNum{{Weekday: time.Tuesday}, {Weekday: time.Thursday}},
+			},
+			want: "FREQ=WEEKLY;BYDAY=TU,TH",
+		},
+		{
+			name: "last weekday of the month",
+			recurrence: &domain.Recurrence{
+				Frequency: "MONTHLY",
+				Interval:  1,
+				ByDay: []domain.WeekdayNum{
+					{Weekday: time.Monday}, {Weekday: time.Tuesday}, {Weekday: time.Wednesday},
+					{Weekday: time.Thursday}, {Weekday: time.Friday},
+				},
+				BySetPos: []int{-1},
+			},
+			want: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+		},
+		{
+			name: "yearly with ordinal weekday and week start",
+			recurrence: &domain.Recurrence{
+				Frequency: "YEARLY",
+				Interval:  1,
+				ByMonth:   []int{5},
+				ByDay:     []domain.WeekdayNum{{N: 2, Weekday: time.Sunday}},
+				WeekStart: weekdayPtr(time.Sunday),
+			},
+			want: "FREQ=YEARLY;BYMONTH=5;BYDAY=2SU;WKST=SU",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := buildRRULE(tt.recurrence)
+			got := FormatRRULE(tt.recurrence, tt.series)
 			if got != tt.want {
-				t.Errorf("buildRRULE() = %q, want %q", got, tt.want)
+				t.Errorf("FormatRRULE() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildRRULE_RoundTrip(t *testing.T) {
+	rules := []string{
+		"FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU",
+		"FREQ=MONTHLY;BYMONTHDAY=-1",
+		"FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO",
+		"FREQ=MONTHLY;UNTIL=20251231T235959Z;BYDAY=-1FR",
+		"FREQ=DAILY;BYHOUR=9,18;BYMINUTE=30",
+	}
+
+	for _, rule := range rules {
+		t.Run(rule, func(t *testing.T) {
+			got := FormatRRULE(parseRRULE(rule), domain.Event{})
+			if got != rule {
+				t.Errorf("round trip = %q, want %q", got, rule)
+			}
+		})
+	}
+}
+
+func TestGenerateEvent_WithExDatesAndRDates(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   domain.Event
+		wantOut []string
+	}{
+		{
+			name: "timed event",
+			event: domain.Event{
+				UID:        "timed",
+				Start:      time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+				End:        time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC),
+				Recurrence: &domain.Recurrence{Frequency: "WEEKLY", Interval: 1},
+				ExDates: []time.Time{
+					time.Date(2025, 9, 8, 10, 0, 0, 0, time.UTC),
+					time.Date(2025, 9, 15, 10, 0, 0, 0, time.UTC),
+				},
+				RDates: []time.Time{time.Date(2025, 9, 10, 10, 0, 0, 0, time.UTC)},
+			},
+			wantOut: []string{
+				"EXDATE:20250908T100000Z,20250915T100000Z",
+				"RDATE:20250910T100000Z",
+			},
+		},
+		{
+			name: "all-day event",
+			event: domain.Event{
+				UID:        "all-day",
+				Start:      time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+				End:        time.Date(2025, 9, 2, 0, 0, 0, 0, time.UTC),
+				AllDay:     true,
+				Recurrence: &domain.Recurrence{Frequency: "DAILY", Interval: 1},
+				ExDates:    []time.Time{time.Date(2025, 9, 3, 0, 0, 0, 0, time.UTC)},
+			},
+			wantOut: []string{"EXDATE;VALUE=DATE:20250903"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := GenerateEvent(tt.event, &buf); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			output := buf.String()
+			for _, want := range tt.wantOut {
+				if !strings.Contains(output, want) {
+					t.Errorf("output should contain %q\nGot output:\n%s", want, output)
+				}
+			}
+
+			parsed, err := ParseEvents(strings.NewReader(output))
+			if err != nil || len(parsed) != 1 {
+				t.Fatalf("failed to parse generated output: %v", err)
+			}
+			if len(parsed[0].ExDates) != len(tt.event.ExDates) || len(parsed[0].RDates) != len(tt.event.RDates) {
+				t.Errorf("round trip lost EXDATE/RDATE values: %+v", parsed[0])
 			}
 		})
 	}
 }
+
+func TestGenerateEvents_WithOverrides(t *testing.T) {
+	original := time.Date(2025, 9, 8, 10, 0, 0, 0, time.UTC)
+	event := domain.Event{
+		UID:        "series-1",
+		Summary:    "Planning",
+		Start:      time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+		End:        time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC),
+		Recurrence: &domain.Recurrence{Frequency: "WEEKLY", Interval: 1, Count: intPtr(4)},
+		Overrides: []domain.Event{
+			{
+				Summary:      "Planning (moved)",
+				Start:        time.Date(2025, 9, 8, 14, 0, 0, 0, time.UTC),
+				End:          time.Date(2025, 9, 8, 15, 0, 0, 0, time.UTC),
+				RecurrenceID: &original,
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := GenerateEvents([]domain.Event{event}, &buf); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	output := buf.String()
+	if strings.Count(output, "BEGIN:VEVENT") != 2 {
+		t.Errorf("expected 2 VEVENTs, got output:\n%s", output)
+	}
+	if strings.Count(output, "UID:series-1") != 2 {
+		t.Errorf("expected override to share the master UID, got output:\n%s", output)
+	}
+	if !strings.Contains(output, "RECURRENCE-ID:20250908T100000Z") {
+		t.Errorf("expected RECURRENCE-ID in output:\n%s", output)
+	}
+
+	parsed, err := ParseEvents(strings.NewReader(output))
+	if err != nil {
+		t.Fatalf("failed to parse generated output: %v", err)
+	}
+	if len(parsed) != 1 || len(parsed[0].Overrides) != 1 {
+		t.Errorf("expected round trip to keep one master with one override, got %+v", parsed)
+	}
+}
+
+func TestGenerateEvent_AllDaySpan(t *testing.T) {
+	event := domain.Event{
+		UID:     "conference",
+		Summary: "Conference",
+		Start:   time.Date(2025, 9, 1, 0, 0, 0, 0, time.Local),
+		End:     time.Date(2025, 9, 4, 0, 0, 0, 0, time.Local),
+		AllDay:  true,
+	}
+
+	var buf bytes.Buffer
+	if err := GenerateEvent(event, &buf); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{"DTSTART;VALUE=DATE:20250901", "DTEND;VALUE=DATE:20250904"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q\nGot output:\n%s", want, output)
+		}
+	}
+
+	parsed, err := ParseEvents(strings.NewReader(output))
+	if err != nil || len(parsed) != 1 {
+		t.Fatalf("failed to parse generated output: %v", err)
+	}
+	if !parsed[0].AllDay || len(parsed[0].Dates()) != 3 {
+		t.Errorf("round trip lost the all-day span: %+v", parsed[0])
+	}
+}
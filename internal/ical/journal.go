@@ -0,0 +1,102 @@
+package ical
+
+import (
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+
+	"github.com/arran4/golang-ical"
+)
+
+func convertToDomainJournal(journal *ics.VJournal, zones *zoneResolver) domain.Journal {
+	domainJournal := domain.Journal{}
+
+	if uid := journal.GetProperty(ics.ComponentProperty(ics.PropertyUid)); uid != nil {
+		domainJournal.UID = uid.Value
+	}
+
+	if summary := journal.GetProperty(ics.ComponentProperty(ics.PropertySummary)); summary != nil {
+		domainJournal.Summary = summary.Value
+	}
+
+	if desc := journal.GetProperty(ics.ComponentProperty(ics.PropertyDescription)); desc != nil {
+		domainJournal.Description = desc.Value
+	}
+
+	if start := journal.GetProperty(ics.ComponentProperty(ics.PropertyDtstart)); start != nil {
+		if startTime, err := parseDateTime(start.Value, zones.location(start)); err == nil {
+			domainJournal.Start = startTime
+			domainJournal.AllDay = isDate(start)
+			domainJournal.Floating = isFloating(start)
+		}
+	}
+
+	return domainJournal
+}
+
+// addJournal adds journal to cal, patching its source like addEvent
+func addJournal(cal *ics.Calendar, journal domain.Journal) {
+	vjournal := newVJournal(journal)
+	if lines, ok := patchJournalSource(journal, vjournal); ok {
+		cal.Components = append(cal.Components, &rawComponent{lines: lines})
+		return
+	}
+	cal.AddVJournal(vjournal)
+}
+
+// newVJournal builds a VJOURNAL holding the properties calcli models
+func newVJournal(journal domain.Journal) *ics.VJournal {
+	vjournal := ics.NewJournal(journal.UID)
+	vjournal.SetSummary(journal.Summary)
+
+	if journal.Description != "" {
+		vjournal.SetDescription(journal.Description)
+	}
+
+	if !journal.Start.IsZero() {
+		value, params := formatDateList([]time.Time{journal.Start}, journalDates(journal))
+		vjournal.SetProperty(ics.ComponentProperty(ics.PropertyDtstart), value, params...)
+	}
+
+	return vjournal
+}
+
+// journalDates returns an event carrying the value type and zone of the
+// entry's DTSTART, for formatDateList
+func journalDates(journal domain.Journal) domain.Event {
+	return domain.Event{Start: journal.Start, AllDay: journal.AllDay, Floating: journal.Floating}
+}
+
+// patchJournalSource returns the source lines of a parsed entry with the
+// properties that changed replaced, like patchSource does for events
+func patchJournalSource(journal domain.Journal, vjournal *ics.VJournal) ([]string, bool) {
+	if journal.Source == "" {
+		return nil, false
+	}
+
+	cal, err := parseSource(journal.Source, journal.SourceZones)
+	if err != nil || len(cal.Journals()) != 1 {
+		return nil, false
+	}
+	source := cal.Journals()[0]
+	original := convertToDomainJournal(source, newZoneResolver(cal))
+
+	var changed []string
+	add := func(differs bool, name string) {
+		if differs {
+			changed = append(changed, name)
+		}
+	}
+
+	_, params := formatDateList(nil, journalDates(journal))
+	dtstart := source.GetProperty(ics.ComponentProperty(ics.PropertyDtstart))
+	reformat := original.AllDay != journal.AllDay || original.Floating != journal.Floating ||
+		dtstart == nil || paramValue(dtstart, ics.ParameterTzid) != tzidOf(params)
+
+	add(original.UID != journal.UID, "UID")
+	add(original.Summary != journal.Summary, "SUMMARY")
+	add(original.Description != journal.Description, "DESCRIPTION")
+	add(reformat || !original.Start.Equal(journal.Start), "DTSTART")
+
+	return patchLines(journal.Source, vjournal.Serialize(rawConfig), changed, nil), true
+}
@@ -0,0 +1,114 @@
+package ical
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+const journalCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Example//Example//EN\r\n" +
+	"BEGIN:VJOURNAL\r\n" +
+	"UID:journal-1\r\n" +
+	"DTSTAMP:20250901T180000Z\r\n" +
+	"DTSTART;VALUE=DATE:20250901\r\n" +
+	"SUMMARY:Standup notes\r\n" +
+	"DESCRIPTION:Release moved to Friday.\\nAsk Bob about the migration.\r\n" +
+	"CATEGORIES:WORK\r\n" +
+	"END:VJOURNAL\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseObjects_Journals(t *testing.T) {
+	objects, err := ParseObjects(strings.NewReader(journalCalendar))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if len(objects.Journals) != 1 {
+		t.Fatalf("expected 1 journal entry, got %d", len(objects.Journals))
+	}
+
+	journal := objects.Journals[0]
+	if journal.Summary != "Standup notes" {
+		t.Errorf("unexpected summary %q", journal.Summary)
+	}
+	if journal.Description != "Release moved to Friday.\nAsk Bob about the migration." {
+		t.Errorf("unexpected description %q", journal.Description)
+	}
+	if !journal.AllDay || journal.Start.Format("2006-01-02 15:04") != "2025-09-01 00:00" {
+		t.Errorf("expected the entry to belong to 2025-09-01, got %v (all-day %v)", journal.Start, journal.AllDay)
+	}
+}
+
+func TestGenerateObjects_Journals(t *testing.T) {
+	objects, err := ParseObjects(strings.NewReader(journalCalendar))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		journal     func() domain.Journal
+		wantLines   []string
+		unwantLines []string
+	}{
+		{
+			name:      "unchanged entry",
+			journal:   func() domain.Journal { return objects.Journals[0] },
+			wantLines: []string{"DTSTAMP:20250901T180000Z", "CATEGORIES:WORK", "DTSTART;VALUE=DATE:20250901", "SUMMARY:Standup notes"},
+		},
+		{
+			name: "edited text",
+			journal: func() domain.Journal {
+				journal := objects.Journals[0]
+				journal.Description = "Release moved to Monday."
+				return journal
+			},
+			wantLines:   []string{"CATEGORIES:WORK", "DESCRIPTION:Release moved to Monday."},
+			unwantLines: []string{"Friday"},
+		},
+		{
+			name: "new entry",
+			journal: func() domain.Journal {
+				return domain.Journal{UID: "journal-2", Summary: "Retro", Start: time.Date(2025, 9, 5, 16, 0, 0, 0, time.UTC)}
+			},
+			wantLines:   []string{"BEGIN:VJOURNAL", "UID:journal-2", "SUMMARY:Retro", "DTSTART:20250905T160000Z"},
+			unwantLines: []string{"DESCRIPTION"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := tt.journal()
+
+			var buf bytes.Buffer
+			if err := GenerateObjects(Objects{Journals: []domain.Journal{journal}}, &buf); err != nil {
+				t.Fatalf("failed to generate: %v", err)
+			}
+			lines := unfoldLines(buf.String())
+
+			for _, want := range tt.wantLines {
+				if !slices.Contains(lines, want) {
+					t.Errorf("expected line %q in output:\n%s", want, buf.String())
+				}
+			}
+			for _, unwant := range tt.unwantLines {
+				if strings.Contains(buf.String(), unwant) {
+					t.Errorf("did not expect %q in output:\n%s", unwant, buf.String())
+				}
+			}
+
+			reparsed, err := ParseObjects(&buf)
+			if err != nil {
+				t.Fatalf("failed to reparse: %v", err)
+			}
+			if len(reparsed.Journals) != 1 || reparsed.Journals[0].Description != journal.Description {
+				t.Errorf("expected entry %+v after round-trip, got %+v", journal, reparsed.Journals)
+			}
+		})
+	}
+}
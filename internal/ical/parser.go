@@ -1,6 +1,8 @@
 package ical
 
 import (
+	"bytes"
+	"fmt"
 	"io"
 	"strconv"
 	"strings"
@@ -11,22 +13,119 @@ import (
 	"github.com/arran4/golang-ical"
 )
 
+// Objects holds the events, todos and journal entries of an iCalendar
+// stream
+type Objects struct {
+	Events   []domain.Event
+	Todos    []domain.Todo
+	Journals []domain.Journal
+}
+
+// IsEmpty reports whether there are no events, todos or journal entries
+func (o Objects) IsEmpty() bool {
+	return len(o.Events) == 0 && len(o.Todos) == 0 && len(o.Journals) == 0
+}
+
 func ParseEvents(r io.Reader) ([]domain.Event, error) {
-	cal, err := ics.ParseCalendar(r)
+	objects, err := ParseObjects(r)
 	if err != nil {
 		return nil, err
 	}
+	return objects.Events, nil
+}
+
+// ParseObjects parses the VEVENTs, VTODOs and VJOURNALs of an iCalendar
+// stream
+func ParseObjects(r io.Reader) (Objects, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Objects{}, err
+	}
+
+	cal, err := ics.ParseCalendar(bytes.NewReader(data))
+	if err != nil {
+		return Objects{}, err
+	}
+
+	zones := newZoneResolver(cal)
+	zoneSources := zoneSources(string(data))
+
+	// Keep the source of each component so unmodelled properties survive
+	// edits
+	sources := componentSources(string(data), "VEVENT")
+	if len(sources) != len(cal.Events()) {
+		sources = nil
+	}
 
 	var events []domain.Event
-	for _, event := range cal.Events() {
-		domainEvent := convertToDomainEvent(event)
+	var overrides []domain.Event
+	for i, event := range cal.Events() {
+		domainEvent := convertToDomainEvent(event, zones)
+		if sources != nil {
+			domainEvent.Source = sources[i]
+			domainEvent.SourceZones = referencedZones(sources[i], zoneSources)
+		}
+		if domainEvent.RecurrenceID != nil {
+			overrides = append(overrides, domainEvent)
+			continue
+		}
 		events = append(events, domainEvent)
 	}
 
-	return events, nil
+	sources = componentSources(string(data), "VTODO")
+	if len(sources) != len(cal.Todos()) {
+		sources = nil
+	}
+
+	var todos []domain.Todo
+	for i, todo := range cal.Todos() {
+		domainTodo := convertToDomainTodo(todo, zones)
+		if sources != nil {
+			domainTodo.Source = sources[i]
+			domainTodo.SourceZones = referencedZones(sources[i], zoneSources)
+		}
+		todos = append(todos, domainTodo)
+	}
+
+	sources = componentSources(string(data), "VJOURNAL")
+	if len(sources) != len(cal.Journals()) {
+		sources = nil
+	}
+
+	var journals []domain.Journal
+	for i, journal := range cal.Journals() {
+		domainJournal := convertToDomainJournal(journal, zones)
+		if sources != nil {
+			domainJournal.Source = sources[i]
+			domainJournal.SourceZones = referencedZones(sources[i], zoneSources)
+		}
+		journals = append(journals, domainJournal)
+	}
+
+	return Objects{Events: attachOverrides(events, overrides), Todos: todos, Journals: journals}, nil
 }
 
-func convertToDomainEvent(event *ics.VEvent) domain.Event {
+// attachOverrides moves RECURRENCE-ID components into their master event.
+// Overrides without a master (e.g. an invitation to a single occurrence)
+// are returned as standalone events.
+func attachOverrides(events, overrides []domain.Event) []domain.Event {
+	for _, override := range overrides {
+		attached := false
+		for i := range events {
+			if events[i].UID == override.UID && events[i].RecurrenceID == nil {
+				events[i].Overrides = append(events[i].Overrides, override)
+				attached = true
+				break
+			}
+		}
+		if !attached {
+			events = append(events, override)
+		}
+	}
+	return events
+}
+
+func convertToDomainEvent(event *ics.VEvent, zones *zoneResolver) domain.Event {
 	domainEvent := domain.Event{}
 
 	if uid := event.GetProperty(ics.ComponentProperty(ics.PropertyUid)); uid != nil {
@@ -45,25 +144,241 @@ func convertToDomainEvent(event *ics.VEvent) domain.Event {
 		domainEvent.Location = location.Value
 	}
 
-	if startTime, err := event.GetStartAt(); err == nil {
-		domainEvent.Start = startTime
+	if start := event.GetProperty(ics.ComponentProperty(ics.PropertyDtstart)); start != nil {
+		if startTime, err := parseDateTime(start.Value, zones.location(start)); err == nil {
+			domainEvent.Start = startTime
+			domainEvent.Floating = isFloating(start)
+			domainEvent.AllDay = isDate(start)
+		}
 	}
 
-	if endTime, err := event.GetEndAt(); err == nil {
-		domainEvent.End = endTime
+	if end := event.GetProperty(ics.ComponentProperty(ics.PropertyDtend)); end != nil {
+		if endTime, err := parseDateTime(end.Value, zones.location(end)); err == nil {
+			domainEvent.End = endTime
+		}
+	} else if duration := event.GetProperty(ics.ComponentProperty(ics.PropertyDuration)); duration != nil {
+		if d, err := parseDuration(duration.Value); err == nil {
+			domainEvent.End = d.addTo(domainEvent.Start)
+		}
 	}
 
-	if startTime, err := event.GetStartAt(); err == nil {
-		domainEvent.AllDay = startTime.Hour() == 0 && startTime.Minute() == 0 && startTime.Second() == 0
+	// An all-day event without DTEND lasts one day
+	if domainEvent.AllDay && !domainEvent.End.After(domainEvent.Start) {
+		domainEvent.End = domainEvent.Start.AddDate(0, 0, 1)
 	}
 
 	if rrule := event.GetProperty(ics.ComponentProperty(ics.PropertyRrule)); rrule != nil {
 		domainEvent.Recurrence = parseRRULE(rrule.Value)
 	}
 
+	if recurrenceID := event.GetProperty(ics.ComponentProperty(ics.PropertyRecurrenceId)); recurrenceID != nil {
+		if t, err := parseDateTime(recurrenceID.Value, zones.location(recurrenceID)); err == nil {
+			domainEvent.RecurrenceID = &t
+		}
+	}
+
+	for _, exdate := range event.GetProperties(ics.ComponentProperty(ics.PropertyExdate)) {
+		domainEvent.ExDates = append(domainEvent.ExDates, parseDateList(exdate, zones)...)
+	}
+
+	for _, rdate := range event.GetProperties(ics.ComponentProperty(ics.PropertyRdate)) {
+		domainEvent.RDates = append(domainEvent.RDates, parseDateList(rdate, zones)...)
+	}
+
+	if organizer := event.GetProperty(ics.ComponentPropertyOrganizer); organizer != nil {
+		attendee := parseAttendee(organizer)
+		domainEvent.Organizer = &attendee
+	}
+
+	for _, attendee := range event.GetProperties(ics.ComponentPropertyAttendee) {
+		domainEvent.Attendees = append(domainEvent.Attendees, parseAttendee(attendee))
+	}
+
+	for _, valarm := range event.Alarms() {
+		if alarm, ok := parseAlarm(valarm, zones); ok {
+			domainEvent.Alarms = append(domainEvent.Alarms, alarm)
+		}
+	}
+
 	return domainEvent
 }
 
+// icsDuration is an RFC 5545 dur-value. Days are kept apart from the time
+// part because a day is not always 24 hours long.
+type icsDuration struct {
+	Days int
+	Time time.Duration
+}
+
+// parseDuration parses a DURATION value such as "PT1H30M", "P1D" or "-P1W"
+func parseDuration(value string) (icsDuration, error) {
+	var d icsDuration
+	sign := 1
+	rest := value
+	switch {
+	case strings.HasPrefix(rest, "-"):
+		sign, rest = -1, rest[1:]
+	case strings.HasPrefix(rest, "+"):
+		rest = rest[1:]
+	}
+	if !strings.HasPrefix(rest, "P") || len(rest) < 3 {
+		return d, fmt.Errorf("invalid duration: %s", value)
+	}
+	rest = rest[1:]
+
+	inTime := false
+	number := ""
+	for _, r := range rest {
+		switch {
+		case r >= '0' && r <= '9':
+			number += string(r)
+			continue
+		case r == 'T':
+			inTime = true
+			continue
+		}
+
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return d, fmt.Errorf("invalid duration: %s", value)
+		}
+		number = ""
+
+		switch {
+		case r == 'W' && !inTime:
+			d.Days += 7 * n
+		case r == 'D' && !inTime:
+			d.Days += n
+		case r == 'H' && inTime:
+			d.Time += time.Duration(n) * time.Hour
+		case r == 'M' && inTime:
+			d.Time += time.Duration(n) * time.Minute
+		case r == 'S' && inTime:
+			d.Time += time.Duration(n) * time.Second
+		default:
+			return d, fmt.Errorf("invalid duration: %s", value)
+		}
+	}
+	if number != "" {
+		return d, fmt.Errorf("invalid duration: %s", value)
+	}
+
+	d.Days *= sign
+	d.Time *= time.Duration(sign)
+	return d, nil
+}
+
+// addTo returns start plus d. Days keep the wall-clock time.
+func (d icsDuration) addTo(start time.Time) time.Time {
+	return start.AddDate(0, 0, d.Days).Add(d.Time)
+}
+
+// parseDateList parses a multi-valued EXDATE or RDATE property, honouring
+// VALUE=DATE and TZID. Invalid values are skipped.
+func parseDateList(prop *ics.IANAProperty, zones *zoneResolver) []time.Time {
+	loc := zones.location(prop)
+
+	var dates []time.Time
+	for _, value := range strings.Split(prop.Value, ",") {
+		value = strings.TrimSpace(value)
+		// RDATE;VALUE=PERIOD uses "start/end" or "start/duration"
+		if idx := strings.Index(value, "/"); idx >= 0 {
+			value = value[:idx]
+		}
+		if t, err := parseDateTime(value, loc); err == nil {
+			dates = append(dates, t)
+		}
+	}
+	return dates
+}
+
+// parseAttendee converts an ORGANIZER or ATTENDEE property
+func parseAttendee(prop *ics.IANAProperty) domain.Attendee {
+	email := prop.Value
+	if len(email) >= len("mailto:") && strings.EqualFold(email[:len("mailto:")], "mailto:") {
+		email = email[len("mailto:"):]
+	}
+
+	return domain.Attendee{
+		Email:  email,
+		Name:   paramValue(prop, ics.ParameterCn),
+		Role:   strings.ToUpper(paramValue(prop, ics.ParameterRole)),
+		Status: strings.ToUpper(paramValue(prop, ics.ParameterParticipationStatus)),
+		RSVP:   strings.EqualFold(paramValue(prop, ics.ParameterRsvp), "TRUE"),
+	}
+}
+
+// parseAlarm converts a VALARM, reporting false when its TRIGGER is missing
+// or invalid
+func parseAlarm(valarm *ics.VAlarm, zones *zoneResolver) (domain.Alarm, bool) {
+	var alarm domain.Alarm
+	if action := valarm.GetProperty(ics.ComponentPropertyAction); action != nil {
+		alarm.Action = strings.ToUpper(action.Value)
+	}
+	if desc := valarm.GetProperty(ics.ComponentPropertyDescription); desc != nil {
+		alarm.Description = desc.Value
+	}
+
+	trigger := valarm.GetProperty(ics.ComponentPropertyTrigger)
+	if trigger == nil {
+		return alarm, false
+	}
+
+	if strings.EqualFold(paramValue(trigger, ics.ParameterValue), string(ics.ValueDataTypeDateTime)) {
+		at, err := parseDateTime(trigger.Value, zones.location(trigger))
+		if err != nil {
+			return alarm, false
+		}
+		alarm.At = &at
+		return alarm, true
+	}
+
+	d, err := parseDuration(trigger.Value)
+	if err != nil {
+		return alarm, false
+	}
+	alarm.Trigger = time.Duration(d.Days)*24*time.Hour + d.Time
+	alarm.RelatedEnd = strings.EqualFold(paramValue(trigger, ics.ParameterRelated), "END")
+	return alarm, true
+}
+
+// paramValue returns the single value of a property parameter, "" when
+// absent
+func paramValue(prop *ics.IANAProperty, param ics.Parameter) string {
+	if values := prop.ICalParameters[string(param)]; len(values) == 1 {
+		return values[0]
+	}
+	return ""
+}
+
+// isDate reports whether a property holds a DATE rather than a DATE-TIME
+func isDate(prop *ics.IANAProperty) bool {
+	if values, ok := prop.ICalParameters[string(ics.ParameterValue)]; ok && len(values) > 0 {
+		return strings.EqualFold(values[0], string(ics.ValueDataTypeDate))
+	}
+	return !strings.Contains(prop.Value, "T")
+}
+
+// isFloating reports whether a DATE-TIME property is bound to no zone
+func isFloating(prop *ics.IANAProperty) bool {
+	if _, ok := prop.ICalParameters[string(ics.ParameterTzid)]; ok {
+		return false
+	}
+	return strings.Contains(prop.Value, "T") && !strings.HasSuffix(prop.Value, "Z")
+}
+
+// parseDateTime parses an iCalendar DATE or DATE-TIME value. Floating and
+// DATE values are interpreted in loc.
+func parseDateTime(value string, loc *time.Location) (time.Time, error) {
+	if strings.HasSuffix(value, "Z") {
+		return time.Parse("20060102T150405Z", value)
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation("20060102", value, loc)
+}
+
 func parseRRULE(rruleStr string) *domain.Recurrence {
 	if rruleStr == "" {
 		return nil
@@ -93,11 +408,94 @@ func parseRRULE(rruleStr string) *domain.Recurrence {
 				recurrence.Count = &count
 			}
 		case "UNTIL":
-			if until, err := time.Parse("20060102T150405Z", value); err == nil {
+			if until, err := parseUntil(value); err == nil {
 				recurrence.Until = &until
 			}
+		case "BYSECOND":
+			recurrence.BySecond = parseIntList(value)
+		case "BYMINUTE":
+			recurrence.ByMinute = parseIntList(value)
+		case "BYHOUR":
+			recurrence.ByHour = parseIntList(value)
+		case "BYDAY":
+			recurrence.ByDay = parseByDay(value)
+		case "BYMONTHDAY":
+			recurrence.ByMonthDay = parseIntList(value)
+		case "BYYEARDAY":
+			recurrence.ByYearDay = parseIntList(value)
+		case "BYWEEKNO":
+			recurrence.ByWeekNo = parseIntList(value)
+		case "BYMONTH":
+			recurrence.ByMonth = parseIntList(value)
+		case "BYSETPOS":
+			recurrence.BySetPos = parseIntList(value)
+		case "WKST":
+			if weekday, ok := weekdayCodes[value]; ok {
+				recurrence.WeekStart = &weekday
+			}
 		}
 	}
 
 	return recurrence
 }
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// parseUntil accepts the UTC, floating and DATE forms of UNTIL
+func parseUntil(value string) (time.Time, error) {
+	if until, err := time.Parse("20060102T150405Z", value); err == nil {
+		return until, nil
+	}
+	if until, err := time.ParseInLocation("20060102T150405", value, time.Local); err == nil {
+		return until, nil
+	}
+	if until, err := time.ParseInLocation("20060102", value, time.Local); err == nil {
+		// A DATE value includes the whole day
+		return until.Add(24*time.Hour - time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL value: %s", value)
+}
+
+// parseIntList parses comma separated integers, skipping invalid entries
+func parseIntList(value string) []int {
+	var values []int
+	for _, item := range strings.Split(value, ",") {
+		if n, err := strconv.Atoi(strings.TrimSpace(item)); err == nil {
+			values = append(values, n)
+		}
+	}
+	return values
+}
+
+// parseByDay parses BYDAY entries such as "MO", "1MO" or "-1FR"
+func parseByDay(value string) []domain.WeekdayNum {
+	var days []domain.WeekdayNum
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) < 2 {
+			continue
+		}
+		weekday, ok := weekdayCodes[item[len(item)-2:]]
+		if !ok {
+			continue
+		}
+		n := 0
+		if prefix := item[:len(item)-2]; prefix != "" {
+			parsed, err := strconv.Atoi(prefix)
+			if err != nil {
+				continue
+			}
+			n = parsed
+		}
+		days = append(days, domain.WeekdayNum{N: n, Weekday: weekday})
+	}
+	return days
+}
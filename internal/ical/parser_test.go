@@ -56,8 +56,8 @@ PRODID:-//Test//Test//EN
 BEGIN:VEVENT
 UID:allday-1
 SUMMARY:All Day Event
-DTSTART:20250827T000000Z
-DTEND:20250828T000000Z
+DTSTART;VALUE=DATE:20250827
+DTEND;VALUE=DATE:20250828
 END:VEVENT
 END:VCALENDAR`,
 			wantErr: false,
@@ -70,6 +70,73 @@ END:VCALENDAR`,
 				if !e.AllDay {
 					t.Error("expected all-day event")
 				}
+				if e.IsMultiDay() {
+					t.Error("expected single-day event with exclusive DTEND")
+				}
+			},
+		},
+		{
+			name: "midnight event is not all-day",
+			icsData: `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Test//Test//EN
+BEGIN:VEVENT
+UID:flight-1
+SUMMARY:Red-eye Flight
+DTSTART:20250827T000000Z
+DTEND:20250827T060000Z
+END:VEVENT
+END:VCALENDAR`,
+			wantErr: false,
+			wantLen: 1,
+			checkEvent: func(t *testing.T, events []domain.Event) {
+				if events[0].AllDay {
+					t.Error("expected timed event starting at midnight")
+				}
+			},
+		},
+		{
+			name: "multi-day all-day event",
+			icsData: `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Test//Test//EN
+BEGIN:VEVENT
+UID:conference-1
+SUMMARY:Conference
+DTSTART;VALUE=DATE:20250901
+DTEND;VALUE=DATE:20250904
+END:VEVENT
+END:VCALENDAR`,
+			wantErr: false,
+			wantLen: 1,
+			checkEvent: func(t *testing.T, events []domain.Event) {
+				e := events[0]
+				if !e.AllDay {
+					t.Error("expected all-day event")
+				}
+				if len(e.Dates()) != 3 {
+					t.Errorf("expected conference to cover 3 days, got %v", e.Dates())
+				}
+			},
+		},
+		{
+			name: "all-day event without DTEND",
+			icsData: `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Test//Test//EN
+BEGIN:VEVENT
+UID:holiday-1
+SUMMARY:Holiday
+DTSTART;VALUE=DATE:20251003
+END:VEVENT
+END:VCALENDAR`,
+			wantErr: false,
+			wantLen: 1,
+			checkEvent: func(t *testing.T, events []domain.Event) {
+				e := events[0]
+				if !e.AllDay || e.End.Sub(e.Start) != 24*time.Hour {
+					t.Errorf("expected one-day all-day event, got %v - %v", e.Start, e.End)
+				}
 			},
 		},
 		{
@@ -203,3 +270,171 @@ func TestParseRRULE(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRRULE_ByParts(t *testing.T) {
+	rec := parseRRULE("FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;BYMONTH=1,6;BYMONTHDAY=-1,15;BYHOUR=9;WKST=SU")
+	if rec == nil {
+		t.Fatal("expected recurrence, got nil")
+	}
+
+	if len(rec.ByDay) != 5 || rec.ByDay[0] != (domain.WeekdayNum{Weekday: time.Monday}) {
+		t.Errorf("unexpected ByDay %+v", rec.ByDay)
+	}
+	if len(rec.BySetPos) != 1 || rec.BySetPos[0] != -1 {
+		t.Errorf("expected BySetPos [-1], got %v", rec.BySetPos)
+	}
+	if len(rec.ByMonth) != 2 || rec.ByMonth[1] != 6 {
+		t.Errorf("expected ByMonth [1 6], got %v", rec.ByMonth)
+	}
+	if len(rec.ByMonthDay) != 2 || rec.ByMonthDay[0] != -1 {
+		t.Errorf("expected ByMonthDay [-1 15], got %v", rec.ByMonthDay)
+	}
+	if len(rec.ByHour) != 1 || rec.ByHour[0] != 9 {
+		t.Errorf("expected ByHour [9], got %v", rec.ByHour)
+	}
+	if rec.WeekStart == nil || *rec.WeekStart != time.Sunday {
+		t.Errorf("expected WeekStart Sunday, got %v", rec.WeekStart)
+	}
+}
+
+func TestParseByDay(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected []domain.WeekdayNum
+	}{
+		{"TU,TH", []domain.WeekdayNum{{Weekday: time.Tuesday}, {Weekday: time.Thursday}}},
+		{"1MO", []domain.WeekdayNum{{N: 1, Weekday: time.Monday}}},
+		{"-1FR", []domain.WeekdayNum{{N: -1, Weekday: time.Friday}}},
+		{"+2WE", []domain.WeekdayNum{{N: 2, Weekday: time.Wednesday}}},
+		{"XX,SA", []domain.WeekdayNum{{Weekday: time.Saturday}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got := parseByDay(tt.value)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("entry %d: expected %v, got %v", i, tt.expected[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseRRULE_UntilDate(t *testing.T) {
+	rec := parseRRULE("FREQ=DAILY;UNTIL=20251231")
+	if rec == nil || rec.Until == nil {
+		t.Fatal("expected UNTIL to be parsed")
+	}
+	if rec.Until.Year() != 2025 || rec.Until.Month() != time.December || rec.Until.Day() != 31 {
+		t.Errorf("expected UNTIL on 2025-12-31, got %v", rec.Until)
+	}
+}
+
+func TestParseEvents_ExDateAndRDate(t *testing.T) {
+	icsData := `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Test//Test//EN
+BEGIN:VEVENT
+UID:weekly-sync
+SUMMARY:Weekly Sync
+DTSTART:20250901T100000Z
+DTEND:20250901T110000Z
+RRULE:FREQ=WEEKLY;COUNT=5
+EXDATE:20250908T100000Z,20250915T100000Z
+EXDATE;TZID=Europe/Berlin:20250922T120000
+RDATE;VALUE=DATE:20250910
+RDATE;VALUE=PERIOD:20250911T150000Z/PT1H
+END:VEVENT
+END:VCALENDAR`
+
+	events, err := ParseEvents(strings.NewReader(icsData))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+
+	e := events[0]
+	if len(e.ExDates) != 3 {
+		t.Fatalf("expected 3 EXDATE values, got %d", len(e.ExDates))
+	}
+	if !e.ExDates[0].Equal(time.Date(2025, 9, 8, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected first EXDATE %v", e.ExDates[0])
+	}
+	if !e.ExDates[2].Equal(time.Date(2025, 9, 22, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected TZID EXDATE to resolve to 10:00 UTC, got %v", e.ExDates[2].UTC())
+	}
+
+	if len(e.RDates) != 2 {
+		t.Fatalf("expected 2 RDATE values, got %d", len(e.RDates))
+	}
+	if y, m, d := e.RDates[0].Date(); y != 2025 || m != time.September || d != 10 {
+		t.Errorf("expected DATE RDATE on 2025-09-10, got %v", e.RDates[0])
+	}
+	if !e.RDates[1].Equal(time.Date(2025, 9, 11, 15, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected PERIOD RDATE to use its start, got %v", e.RDates[1])
+	}
+}
+
+func TestParseEvents_RecurrenceIDOverrides(t *testing.T) {
+	icsData := `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Test//Test//EN
+BEGIN:VEVENT
+UID:series-1
+SUMMARY:Planning
+LOCATION:Room 1
+DTSTART:20250901T100000Z
+DTEND:20250901T110000Z
+RRULE:FREQ=WEEKLY;COUNT=4
+END:VEVENT
+BEGIN:VEVENT
+UID:series-1
+RECURRENCE-ID:20250908T100000Z
+SUMMARY:Planning
+LOCATION:Room 2
+DTSTART:20250908T140000Z
+DTEND:20250908T150000Z
+END:VEVENT
+BEGIN:VEVENT
+UID:orphan-1
+RECURRENCE-ID;TZID=Europe/Berlin:20250910T090000
+SUMMARY:Single occurrence invite
+DTSTART;TZID=Europe/Berlin:20250910T090000
+DTEND;TZID=Europe/Berlin:20250910T100000
+END:VEVENT
+END:VCALENDAR`
+
+	events, err := ParseEvents(strings.NewReader(icsData))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected master and orphan override, got %d events", len(events))
+	}
+
+	master := events[0]
+	if master.UID != "series-1" || master.RecurrenceID != nil {
+		t.Fatalf("expected master of series-1 first, got %+v", master)
+	}
+	if len(master.Overrides) != 1 {
+		t.Fatalf("expected 1 override, got %d", len(master.Overrides))
+	}
+	override := master.Overrides[0]
+	if override.Location != "Room 2" {
+		t.Errorf("expected override location 'Room 2', got %s", override.Location)
+	}
+	if !override.RecurrenceID.Equal(time.Date(2025, 9, 8, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected RECURRENCE-ID %v", override.RecurrenceID)
+	}
+
+	orphan := events[1]
+	if orphan.UID != "orphan-1" || orphan.RecurrenceID == nil {
+		t.Errorf("expected standalone orphan override, got %+v", orphan)
+	}
+}
@@ -0,0 +1,456 @@
+package ical
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+const richEvent = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Example//Example//EN\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:rich-1\r\n" +
+	"DTSTAMP:20250801T080000Z\r\n" +
+	"SEQUENCE:3\r\n" +
+	"SUMMARY:Planning\r\n" +
+	"DESCRIPTION:Quarterly planning\r\n" +
+	"DTSTART:20250901T100000Z\r\n" +
+	"DURATION:PT90M\r\n" +
+	"ORGANIZER;CN=Alice:mailto:alice@example.com\r\n" +
+	"ATTENDEE;PARTSTAT=ACCEPTED;CN=Bob:mailto:bob@example.com\r\n" +
+	"CATEGORIES:WORK,PLANNING\r\n" +
+	"X-GOOGLE-CONFERENCE:https://meet.example.com/abc\r\n" +
+	"ATTACH:https://example.com/agenda.pdf\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"DESCRIPTION:Reminder\r\n" +
+	"TRIGGER:-PT15M\r\n" +
+	"X-WR-ALARMUID:alarm-1\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+var preservedLines = []string{
+	"DTSTAMP:20250801T080000Z",
+	"SEQUENCE:3",
+	"ORGANIZER;CN=Alice:mailto:alice@example.com",
+	"ATTENDEE;PARTSTAT=ACCEPTED;CN=Bob:mailto:bob@example.com",
+	"CATEGORIES:WORK,PLANNING",
+	"X-GOOGLE-CONFERENCE:https://meet.example.com/abc",
+	"ATTACH:https://example.com/agenda.pdf",
+	"BEGIN:VALARM",
+	"TRIGGER:-PT15M",
+	"END:VALARM",
+}
+
+func TestGenerateEvents_PreservesUnknownProperties(t *testing.T) {
+	events, err := ParseEvents(strings.NewReader(richEvent))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+	event := events[0]
+	if got := event.EndTime().Sub(event.Start); got != 90*time.Minute {
+		t.Errorf("expected DURATION to give a 90 minute event, got %v", got)
+	}
+
+	tests := []struct {
+		name        string
+		edit        func()
+		wantLines   []string
+		unwantLines []string
+	}{
+		{
+			name:      "unchanged event",
+			edit:      func() {},
+			wantLines: []string{"SUMMARY:Planning", "DESCRIPTION:Quarterly planning", "DTSTART:20250901T100000Z", "DURATION:PT90M"},
+		},
+		{
+			name:        "new title",
+			edit:        func() { event.Summary = "Planning (moved)" },
+			wantLines:   []string{"SUMMARY:Planning (moved)", "DESCRIPTION:Quarterly planning", "DTSTART:20250901T100000Z", "DURATION:PT90M"},
+			unwantLines: []string{"SUMMARY:Planning\r\n"},
+		},
+		{
+			name: "new time",
+			edit: func() {
+				event.Start = event.Start.Add(time.Hour)
+				event.End = event.Start.Add(90 * time.Minute)
+			},
+			wantLines:   []string{"DTSTART:20250901T110000Z", "DTEND:20250901T123000Z"},
+			unwantLines: []string{"DURATION:"},
+		},
+		{
+			name:        "cleared description",
+			edit:        func() { event.Description = "" },
+			wantLines:   []string{"SUMMARY:Planning", "DURATION:PT90M"},
+			unwantLines: []string{"DESCRIPTION:Quarterly planning"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event = events[0]
+			tt.edit()
+
+			var buf bytes.Buffer
+			if err := GenerateEvent(event, &buf); err != nil {
+				t.Fatalf("failed to generate: %v", err)
+			}
+			output := buf.String()
+
+			for _, want := range append(preservedLines, tt.wantLines...) {
+				if !strings.Contains(output, want) {
+					t.Errorf("output should contain %q\nGot output:\n%s", want, output)
+				}
+			}
+			for _, unwant := range tt.unwantLines {
+				if strings.Contains(output, unwant) {
+					t.Errorf("output should not contain %q\nGot output:\n%s", unwant, output)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateEvents_UnchangedEventIsVerbatim(t *testing.T) {
+	events, err := ParseEvents(strings.NewReader(richEvent))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := GenerateEvent(events[0], &buf); err != nil {
+		t.Fatalf("failed to generate: %v", err)
+	}
+
+	source := strings.ReplaceAll(richEvent, "\r\n", "\n")
+	start := strings.Index(source, "BEGIN:VEVENT")
+	end := strings.Index(source, "END:VCALENDAR")
+	if want := source[start:end]; !strings.Contains(buf.String(), want) {
+		t.Errorf("expected VEVENT to be written unchanged:\n%s\nGot output:\n%s", want, buf.String())
+	}
+}
+
+func TestGenerateEvents_NormalizesPrefixedTZID(t *testing.T) {
+	if _, err := time.LoadLocation("Europe/Berlin"); err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	data := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//Example//Example//EN\r\n" +
+		"BEGIN:VEVENT\r\nUID:tz-1\r\nSUMMARY:Call\r\nX-CUSTOM:kept\r\n" +
+		"DTSTART;TZID=/mozilla.org/20050126_1/Europe/Berlin:20250901T100000\r\n" +
+		"DTEND;TZID=/mozilla.org/20050126_1/Europe/Berlin:20250901T110000\r\n" +
+		"END:VEVENT\r\nEND:VCALENDAR\r\n"
+
+	events, err := ParseEvents(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := GenerateEvent(events[0], &buf); err != nil {
+		t.Fatalf("failed to generate: %v", err)
+	}
+	output := buf.String()
+
+	// The TZID must match the VTIMEZONE written alongside the event
+	for _, want := range []string{"X-CUSTOM:kept", "DTSTART;TZID=Europe/Berlin:20250901T100000", "TZID:Europe/Berlin"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q\nGot output:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "mozilla.org") {
+		t.Errorf("expected prefixed TZID to be rewritten\nGot output:\n%s", output)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    icsDuration
+		wantErr bool
+	}{
+		{value: "PT1H30M", want: icsDuration{Time: 90 * time.Minute}},
+		{value: "P1D", want: icsDuration{Days: 1}},
+		{value: "P2W", want: icsDuration{Days: 14}},
+		{value: "P1DT12H", want: icsDuration{Days: 1, Time: 12 * time.Hour}},
+		{value: "-PT15M", want: icsDuration{Time: -15 * time.Minute}},
+		{value: "+PT45S", want: icsDuration{Time: 45 * time.Second}},
+		{value: "PT", wantErr: true},
+		{value: "P1H", wantErr: true},
+		{value: "1H", wantErr: true},
+		{value: "PT5", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseDuration(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("parseDuration(%q) = %+v, want %+v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAttendees_RoundTrip(t *testing.T) {
+	events, err := ParseEvents(strings.NewReader(richEvent))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	event := events[0]
+
+	wantOrganizer := domain.Attendee{Email: "alice@example.com", Name: "Alice"}
+	if event.Organizer == nil || *event.Organizer != wantOrganizer {
+		t.Errorf("expected organizer %+v, got %+v", wantOrganizer, event.Organizer)
+	}
+	wantBob := domain.Attendee{Email: "bob@example.com", Name: "Bob", Status: "ACCEPTED"}
+	if len(event.Attendees) != 1 || event.Attendees[0] != wantBob {
+		t.Fatalf("expected attendees [%+v], got %+v", wantBob, event.Attendees)
+	}
+
+	carol := domain.Attendee{Email: "carol@example.com", Name: "Carol Smith", Role: "REQ-PARTICIPANT", Status: "NEEDS-ACTION", RSVP: true}
+
+	tests := []struct {
+		name        string
+		attendees   []domain.Attendee
+		wantLines   []string
+		unwantLines []string
+	}{
+		{
+			name:      "added attendee",
+			attendees: []domain.Attendee{wantBob, carol},
+			wantLines: []string{
+				// Unchanged attendees keep their source line
+				"ATTENDEE;PARTSTAT=ACCEPTED;CN=Bob:mailto:bob@example.com",
+				`ATTENDEE;CN=Carol Smith;PARTSTAT=NEEDS-ACTION;ROLE=REQ-PARTICIPANT;RSVP=TRUE:mailto:carol@example.com`,
+			},
+		},
+		{
+			name:        "removed attendee",
+			attendees:   nil,
+			wantLines:   []string{"ORGANIZER;CN=Alice:mailto:alice@example.com"},
+			unwantLines: []string{"ATTENDEE"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edited := event
+			edited.Attendees = tt.attendees
+
+			var buf bytes.Buffer
+			if err := GenerateEvent(edited, &buf); err != nil {
+				t.Fatalf("failed to generate: %v", err)
+			}
+			output := strings.Join(unfoldLines(buf.String()), "\n")
+
+			for _, want := range tt.wantLines {
+				if !strings.Contains(output, want) {
+					t.Errorf("output should contain %q\nGot output:\n%s", want, output)
+				}
+			}
+			for _, unwant := range tt.unwantLines {
+				if strings.Contains(output, unwant) {
+					t.Errorf("output should not contain %q\nGot output:\n%s", unwant, output)
+				}
+			}
+
+			reparsed, err := ParseEvents(strings.NewReader(output))
+			if err != nil {
+				t.Fatalf("failed to parse generated output: %v", err)
+			}
+			if !slices.Equal(reparsed[0].Attendees, tt.attendees) {
+				t.Errorf("expected attendees %+v after round trip, got %+v", tt.attendees, reparsed[0].Attendees)
+			}
+		})
+	}
+}
+
+func TestAlarms_RoundTrip(t *testing.T) {
+	events, err := ParseEvents(strings.NewReader(richEvent))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	event := events[0]
+
+	reminder := domain.Alarm{Action: "DISPLAY", Description: "Reminder", Trigger: -15 * time.Minute}
+	if len(event.Alarms) != 1 || !event.Alarms[0].Equal(reminder) {
+		t.Fatalf("expected alarms [%+v], got %+v", reminder, event.Alarms)
+	}
+
+	at := time.Date(2025, 9, 1, 8, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		alarms      []domain.Alarm
+		wantLines   []string
+		unwantLines []string
+	}{
+		{
+			name: "added alarms",
+			alarms: []domain.Alarm{
+				reminder,
+				{Action: "AUDIO", Trigger: 0, RelatedEnd: true},
+				{Action: "DISPLAY", Description: "Prepare", At: &at},
+			},
+			wantLines: []string{
+				// Unchanged alarms keep their source
+				"TRIGGER:-PT15M\nX-WR-ALARMUID:alarm-1\nEND:VALARM",
+				"ACTION:AUDIO\nTRIGGER;RELATED=END:PT0S\nEND:VALARM",
+				"TRIGGER;VALUE=DATE-TIME:20250901T080000Z\nDESCRIPTION:Prepare",
+			},
+		},
+		{
+			name:        "changed alarm",
+			alarms:      []domain.Alarm{{Action: "DISPLAY", Description: "Reminder", Trigger: -time.Hour}},
+			wantLines:   []string{"TRIGGER:-PT1H"},
+			unwantLines: []string{"TRIGGER:-PT15M", "X-WR-ALARMUID"},
+		},
+		{
+			name:        "removed alarm",
+			unwantLines: []string{"VALARM"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edited := event
+			edited.Alarms = tt.alarms
+
+			var buf bytes.Buffer
+			if err := GenerateEvent(edited, &buf); err != nil {
+				t.Fatalf("failed to generate: %v", err)
+			}
+			output := strings.Join(unfoldLines(buf.String()), "\n")
+
+			for _, want := range tt.wantLines {
+				if !strings.Contains(output, want) {
+					t.Errorf("output should contain %q\nGot output:\n%s", want, output)
+				}
+			}
+			for _, unwant := range tt.unwantLines {
+				if strings.Contains(output, unwant) {
+					t.Errorf("output should not contain %q\nGot output:\n%s", unwant, output)
+				}
+			}
+
+			reparsed, err := ParseEvents(strings.NewReader(output))
+			if err != nil {
+				t.Fatalf("failed to parse generated output: %v", err)
+			}
+			if !slices.EqualFunc(reparsed[0].Alarms, tt.alarms, domain.Alarm.Equal) {
+				t.Errorf("expected alarms %+v after round trip, got %+v", tt.alarms, reparsed[0].Alarms)
+			}
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{d: -15 * time.Minute, want: "-PT15M"},
+		{d: 90 * time.Minute, want: "PT1H30M"},
+		{d: -24 * time.Hour, want: "-P1D"},
+		{d: 36 * time.Hour, want: "P1DT12H"},
+		{d: 45 * time.Second, want: "PT45S"},
+		{d: 0, want: "PT0S"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := formatDuration(tt.d); got != tt.want {
+				t.Errorf("formatDuration(%v) = %q, want %q", tt.d, got, tt.want)
+			}
+			parsed, err := parseDuration(tt.want)
+			if err != nil {
+				t.Fatalf("failed to parse %q: %v", tt.want, err)
+			}
+			if got := time.Duration(parsed.Days)*24*time.Hour + parsed.Time; got != tt.d {
+				t.Errorf("parseDuration(%q) = %v, want %v", tt.want, got, tt.d)
+			}
+		})
+	}
+}
+
+func TestGenerateEvents_KeepsSourceTimezones(t *testing.T) {
+	zone := "BEGIN:VTIMEZONE\r\n" +
+		"TZID:W. Europe Standard Time\r\n" +
+		"BEGIN:STANDARD\r\n" +
+		"DTSTART:16010101T030000\r\n" +
+		"TZOFFSETFROM:+0200\r\n" +
+		"TZOFFSETTO:+0100\r\n" +
+		"RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=10\r\n" +
+		"END:STANDARD\r\n" +
+		"BEGIN:DAYLIGHT\r\n" +
+		"DTSTART:16010101T020000\r\n" +
+		"TZOFFSETFROM:+0100\r\n" +
+		"TZOFFSETTO:+0200\r\n" +
+		"RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=3\r\n" +
+		"END:DAYLIGHT\r\n" +
+		"X-MICROSOFT-CDO-TZID:4\r\n" +
+		"END:VTIMEZONE\r\n"
+	data := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"PRODID:-//Example//Example//EN\r\n" +
+		zone +
+		"BEGIN:VEVENT\r\n" +
+		"UID:outlook-1\r\n" +
+		"SUMMARY:Review\r\n" +
+		"DTSTART;TZID=W. Europe Standard Time:20250701T100000\r\n" +
+		"DTEND;TZID=W. Europe Standard Time:20250701T110000\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	events, err := ParseEvents(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	event := events[0]
+	event.Summary = "Review (moved)"
+
+	var buf bytes.Buffer
+	if err := GenerateEvent(event, &buf); err != nil {
+		t.Fatalf("failed to generate: %v", err)
+	}
+	output := buf.String()
+	zone = strings.ReplaceAll(zone, "\r\n", "\n")
+
+	for _, want := range []string{zone, "SUMMARY:Review (moved)", "DTSTART;TZID=W. Europe Standard Time:20250701T100000"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q\nGot output:\n%s", want, output)
+		}
+	}
+	if got := strings.Count(output, "BEGIN:VTIMEZONE"); got != 1 {
+		t.Errorf("expected 1 VTIMEZONE, got %d:\n%s", got, output)
+	}
+
+	// Zones the file didn't declare are still generated
+	seoul := loadLocation(t, "Asia/Seoul")
+	event.Start = time.Date(2025, 7, 1, 10, 0, 0, 0, seoul)
+	event.End = event.Start.Add(time.Hour)
+	buf.Reset()
+	if err := GenerateEvent(event, &buf); err != nil {
+		t.Fatalf("failed to generate: %v", err)
+	}
+	if output := buf.String(); !strings.Contains(output, "TZID:Asia/Seoul") || !strings.Contains(output, zone) {
+		t.Errorf("expected the declared and the generated zone:\n%s", output)
+	}
+}
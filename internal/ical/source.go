@@ -0,0 +1,367 @@
+package ical
+
+import (
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+
+	"github.com/arran4/golang-ical"
+)
+
+// rawComponent writes content lines exactly as given, bypassing the
+// escaping ics applies to property values it parsed
+type rawComponent struct {
+	lines []string
+}
+
+func (c *rawComponent) UnknownPropertiesIANAProperties() []ics.IANAProperty {
+	return nil
+}
+
+func (c *rawComponent) SubComponents() []ics.Component {
+	return nil
+}
+
+func (c *rawComponent) SerializeTo(w io.Writer, serialConfig *ics.SerializationConfiguration) error {
+	for _, line := range c.lines {
+		if _, err := io.WriteString(w, foldLine(line, serialConfig.MaxLength, serialConfig.NewLine)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// componentSources returns the unfolded content lines of every top-level
+// component called name (VEVENT, VTODO) in data, in the order they appear
+func componentSources(data, name string) []string {
+	var sources []string
+	var current []string
+	depth := 0
+
+	for _, line := range unfoldLines(data) {
+		upper := strings.ToUpper(line)
+		switch {
+		case depth == 0 && upper == "BEGIN:"+name:
+			depth = 1
+			current = []string{line}
+			continue
+		case depth == 0:
+			continue
+		case strings.HasPrefix(upper, "BEGIN:"):
+			depth++
+		case strings.HasPrefix(upper, "END:"):
+			depth--
+		}
+
+		current = append(current, line)
+		if depth == 0 {
+			sources = append(sources, strings.Join(current, "\r\n")+"\r\n")
+		}
+	}
+
+	return sources
+}
+
+// zoneSources maps the TZID of every VTIMEZONE component in data to its
+// source
+func zoneSources(data string) map[string]string {
+	zones := make(map[string]string)
+	for _, source := range componentSources(data, "VTIMEZONE") {
+		if tzid := zoneID(source); tzid != "" {
+			zones[tzid] = source
+		}
+	}
+	return zones
+}
+
+// zoneID returns the TZID of the source of a VTIMEZONE component
+func zoneID(source string) string {
+	for _, line := range unfoldLines(source) {
+		if propertyName(line) == "TZID" {
+			if i := strings.Index(line, ":"); i >= 0 {
+				return line[i+1:]
+			}
+		}
+	}
+	return ""
+}
+
+var tzidParam = regexp.MustCompile(`(?i);TZID=("[^"]*"|[^;:]*)`)
+
+// referencedZones returns the sources among zones of the TZIDs source
+// refers to, in the order they first appear
+func referencedZones(source string, zones map[string]string) []string {
+	var referenced []string
+	seen := make(map[string]bool)
+	for _, match := range tzidParam.FindAllStringSubmatch(source, -1) {
+		tzid := strings.Trim(match[1], `"`)
+		if zone, ok := zones[tzid]; ok && !seen[tzid] {
+			seen[tzid] = true
+			referenced = append(referenced, zone)
+		}
+	}
+	return referenced
+}
+
+// parseSource parses the source of a component, wrapped in a VCALENDAR
+// along with the sources of the zones it refers to
+func parseSource(source string, zones []string) (*ics.Calendar, error) {
+	return ics.ParseCalendar(strings.NewReader("BEGIN:VCALENDAR\r\n" + strings.Join(zones, "") + source + "END:VCALENDAR\r\n"))
+}
+
+// patchSource returns the content lines of the component event was parsed
+// from, with the properties and alarms whose modelled value changed replaced
+// by their counterparts in vevent
+func patchSource(event domain.Event, vevent *ics.VEvent) ([]string, bool) {
+	if event.Source == "" {
+		return nil, false
+	}
+
+	cal, err := parseSource(event.Source, event.SourceZones)
+	if err != nil || len(cal.Events()) != 1 {
+		return nil, false
+	}
+	source := cal.Events()[0]
+	original := convertToDomainEvent(source, newZoneResolver(cal))
+
+	changed := changedItems(original, event, source.GetProperty(ics.ComponentProperty(ics.PropertyDtstart)))
+	return patchLines(event.Source, vevent.Serialize(rawConfig), changed, func(name string, generated, source [][]string) [][]string {
+		switch name {
+		case "ATTENDEE":
+			return keepUnchanged(generated, event.Attendees, source, original.Attendees,
+				func(a, b domain.Attendee) bool { return a == b })
+		case "VALARM":
+			return keepUnchanged(generated, event.Alarms, source, original.Alarms, domain.Alarm.Equal)
+		}
+		return generated
+	}), true
+}
+
+// rawConfig serializes components without folding, for patchLines
+var rawConfig = &ics.SerializationConfiguration{MaxLength: 1 << 30, NewLine: "\r\n"}
+
+// patchLines returns the lines of the source component with the items
+// named in changed replaced by those of the generated component. keep, when
+// not nil, may swap replacements back for source items that did not really
+// change.
+func patchLines(source, generated string, changed []string, keep func(name string, generated, source [][]string) [][]string) []string {
+	lines := unfoldLines(source)
+	items := componentItems(lines)
+	generatedItems := componentItems(unfoldLines(generated))
+
+	for _, name := range changed {
+		replacement := selectItems(generatedItems, name)
+		if keep != nil {
+			replacement = keep(name, replacement, selectItems(items, name))
+		}
+		items = replaceItems(items, name, replacement)
+	}
+
+	patched := []string{lines[0]}
+	for _, item := range items {
+		patched = append(patched, item...)
+	}
+	return append(patched, lines[len(lines)-1])
+}
+
+// changedItems lists the properties and nested components of original that
+// must be rewritten to describe event. Dates are also rewritten when the way
+// they are written (DATE, floating, TZID) changes.
+func changedItems(original, event domain.Event, dtstart *ics.IANAProperty) []string {
+	var changed []string
+	add := func(differs bool, names ...string) {
+		if differs {
+			changed = append(changed, names...)
+		}
+	}
+
+	_, params := formatDateList(nil, event)
+	reformat := original.AllDay != event.AllDay || original.Floating != event.Floating ||
+		dtstart == nil || paramValue(dtstart, ics.ParameterTzid) != tzidOf(params)
+
+	add(original.UID != event.UID, "UID")
+	add(original.Summary != event.Summary, "SUMMARY")
+	add(original.Description != event.Description, "DESCRIPTION")
+	add(original.Location != event.Location, "LOCATION")
+	add(reformat || !original.Start.Equal(event.Start), "DTSTART")
+	add(reformat || !original.EndTime().Equal(event.EndTime()), "DTEND", "DURATION")
+	add(reformat || !equalTimes(optionalTime(original.RecurrenceID), optionalTime(event.RecurrenceID)), "RECURRENCE-ID")
+	add(FormatRRULE(original.Recurrence, original) != FormatRRULE(event.Recurrence, event), "RRULE")
+	add(reformat || !equalTimes(original.ExDates, event.ExDates), "EXDATE")
+	add(reformat || !equalTimes(original.RDates, event.RDates), "RDATE")
+	add(!equalAttendee(original.Organizer, event.Organizer), "ORGANIZER")
+	add(!slices.Equal(original.Attendees, event.Attendees), "ATTENDEE")
+	add(!slices.EqualFunc(original.Alarms, event.Alarms, domain.Alarm.Equal), "VALARM")
+	return changed
+}
+
+// keepUnchanged swaps the generated item of every value that did not change
+// for its source item, which may carry what calcli doesn't model, such as
+// the CUTYPE of an attendee or the X- properties of an alarm
+func keepUnchanged[T any](generated [][]string, values []T, source [][]string, original []T, equal func(a, b T) bool) [][]string {
+	if len(generated) != len(values) || len(source) != len(original) {
+		return generated
+	}
+
+	items := make([][]string, len(generated))
+	for i, value := range values {
+		items[i] = generated[i]
+		for j := range original {
+			if equal(original[j], value) {
+				items[i] = source[j]
+				break
+			}
+		}
+	}
+	return items
+}
+
+// componentItems splits the lines of a component, without its BEGIN and END
+// lines, into top-level property lines and nested component blocks
+func componentItems(lines []string) [][]string {
+	var items [][]string
+	depth := 0
+	for _, line := range lines[1 : len(lines)-1] {
+		upper := strings.ToUpper(line)
+		if depth == 0 {
+			items = append(items, []string{line})
+		} else {
+			items[len(items)-1] = append(items[len(items)-1], line)
+		}
+
+		switch {
+		case strings.HasPrefix(upper, "BEGIN:"):
+			depth++
+		case strings.HasPrefix(upper, "END:"):
+			depth--
+		}
+	}
+	return items
+}
+
+// itemName returns the name of a property, or of a nested component
+func itemName(item []string) string {
+	name := propertyName(item[0])
+	if name == "BEGIN" {
+		return strings.ToUpper(item[0][len("BEGIN:"):])
+	}
+	return name
+}
+
+// selectItems returns the items called name
+func selectItems(items [][]string, name string) [][]string {
+	var found [][]string
+	for _, item := range items {
+		if itemName(item) == name {
+			found = append(found, item)
+		}
+	}
+	return found
+}
+
+// replaceItems replaces the items called name with replacement, placed
+// where the first one was. New properties go before the nested components
+// and new components after them.
+func replaceItems(items [][]string, name string, replacement [][]string) [][]string {
+	properties := len(replacement) > 0 && len(replacement[0]) == 1
+
+	var out [][]string
+	inserted := false
+	for _, item := range items {
+		nested := len(item) > 1
+		if !inserted && (itemName(item) == name || (nested && properties)) {
+			out = append(out, replacement...)
+			inserted = true
+		}
+		if itemName(item) != name {
+			out = append(out, item)
+		}
+	}
+	if !inserted {
+		out = append(out, replacement...)
+	}
+	return out
+}
+
+// propertyName returns the upper-cased name of a content line
+func propertyName(line string) string {
+	if i := strings.IndexAny(line, ";:"); i >= 0 {
+		return strings.ToUpper(line[:i])
+	}
+	return strings.ToUpper(line)
+}
+
+// unfoldLines splits iCalendar data into content lines, joining folded
+// continuation lines
+func unfoldLines(data string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// foldLine terminates line with newline, folding it into chunks of at most
+// maxLength octets without splitting UTF-8 characters
+func foldLine(line string, maxLength int, newline string) string {
+	var b strings.Builder
+	limit := maxLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut] + newline + " ")
+		line = line[cut:]
+		limit = maxLength - 1
+	}
+	b.WriteString(line + newline)
+	return b.String()
+}
+
+// tzidOf returns the TZID among params, "" when absent
+func tzidOf(params []ics.PropertyParameter) string {
+	for _, param := range params {
+		if key, values := param.KeyValue(); key == string(ics.ParameterTzid) && len(values) == 1 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+func equalAttendee(a, b *domain.Attendee) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func optionalTime(t *time.Time) []time.Time {
+	if t == nil {
+		return nil
+	}
+	return []time.Time{*t}
+}
+
+func equalTimes(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
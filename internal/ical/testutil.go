@@ -9,3 +9,7 @@ func intPtr(i int) *int {
 func timePtr(t time.Time) *time.Time {
 	return &t
 }
+
+func weekdayPtr(w time.Weekday) *time.Weekday {
+	return &w
+}
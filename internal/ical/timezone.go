@@ -0,0 +1,439 @@
+package ical
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+
+	"github.com/arran4/golang-ical"
+)
+
+// zoneResolver maps TZID parameters to locations. Identifiers that are not
+// IANA names fall back to the zone declared in the calendar's VTIMEZONE.
+type zoneResolver struct {
+	declared map[string]*time.Location
+}
+
+func newZoneResolver(cal *ics.Calendar) *zoneResolver {
+	zones := &zoneResolver{declared: make(map[string]*time.Location)}
+
+	for _, tz := range cal.Timezones() {
+		tzid := tz.GetProperty(ics.ComponentPropertyTzid)
+		if tzid == nil {
+			continue
+		}
+		if loc, ok := declaredZone(tzid.Value, tz); ok {
+			zones.declared[tzid.Value] = loc
+		}
+	}
+
+	return zones
+}
+
+// DeclaredZones returns the locations described by the sources of
+// VTIMEZONE components, such as domain.Event.SourceZones, by TZID
+func DeclaredZones(sources []string) map[string]*time.Location {
+	cal, err := parseSource("", sources)
+	if err != nil {
+		return nil
+	}
+	return newZoneResolver(cal).declared
+}
+
+// location returns the zone of a property, or time.Local for floating and
+// UTC values (the latter carry their own zone)
+func (z *zoneResolver) location(prop *ics.IANAProperty) *time.Location {
+	tzid, ok := prop.ICalParameters[string(ics.ParameterTzid)]
+	if !ok || len(tzid) != 1 {
+		return time.Local
+	}
+	return z.resolve(tzid[0])
+}
+
+func (z *zoneResolver) resolve(tzid string) *time.Location {
+	if loc, err := time.LoadLocation(tzid); err == nil {
+		return loc
+	}
+
+	// Prefixed identifiers such as "/mozilla.org/20050126_1/Europe/Berlin"
+	parts := strings.Split(strings.Trim(tzid, "/"), "/")
+	for i := 1; i < len(parts); i++ {
+		if loc, err := time.LoadLocation(strings.Join(parts[i:], "/")); err == nil {
+			return loc
+		}
+	}
+
+	if loc, ok := z.declared[tzid]; ok {
+		return loc
+	}
+
+	return time.Local
+}
+
+// transitionsEnd bounds the offset changes computed from observance rules
+var transitionsEnd = time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// observance is a STANDARD or DAYLIGHT block of a VTIMEZONE
+type observance struct {
+	name       string
+	offsetFrom int
+	offsetTo   int
+	dst        bool
+	onsets     []time.Time // instants the observance takes effect
+}
+
+// declaredZone builds the location described by a VTIMEZONE. Zones with a
+// DAYLIGHT observance change offsets at every onset of their observances;
+// others keep the offset of their STANDARD observance.
+func declaredZone(tzid string, tz *ics.VTimezone) (*time.Location, bool) {
+	var observances []observance
+	daylight := false
+	for _, component := range tz.Components {
+		var o observance
+		var ok bool
+		switch c := component.(type) {
+		case *ics.Standard:
+			o, ok = parseObservance(&c.ComponentBase, false)
+		case *ics.Daylight:
+			o, ok = parseObservance(&c.ComponentBase, true)
+		}
+		if ok {
+			observances = append(observances, o)
+			daylight = daylight || o.dst
+		}
+	}
+
+	if daylight {
+		if loc, err := transitionZone(tzid, observances); err == nil {
+			return loc, true
+		}
+	}
+	if offset, ok := standardOffset(tz); ok {
+		return time.FixedZone(tzid, offset), true
+	}
+	return nil, false
+}
+
+// parseObservance reads the offsets of an observance and the onsets given
+// by its DTSTART, RRULE and RDATE properties, all local times in the offset
+// in effect before the onset
+func parseObservance(component *ics.ComponentBase, dst bool) (observance, bool) {
+	o := observance{dst: dst}
+	var err error
+	from := component.GetProperty(ics.ComponentProperty(ics.PropertyTzoffsetfrom))
+	to := component.GetProperty(ics.ComponentProperty(ics.PropertyTzoffsetto))
+	dtstart := component.GetProperty(ics.ComponentPropertyDtStart)
+	if from == nil || to == nil || dtstart == nil {
+		return o, false
+	}
+	if o.offsetFrom, err = parseOffset(from.Value); err != nil {
+		return o, false
+	}
+	if o.offsetTo, err = parseOffset(to.Value); err != nil {
+		return o, false
+	}
+	if name := component.GetProperty(ics.ComponentProperty(ics.PropertyTzname)); name != nil {
+		o.name = name.Value
+	}
+
+	before := time.FixedZone("", o.offsetFrom)
+	start, err := time.ParseInLocation("20060102T150405", dtstart.Value, before)
+	if err != nil {
+		return o, false
+	}
+	if rrule := component.GetProperty(ics.ComponentProperty(ics.PropertyRrule)); rrule != nil {
+		series := domain.Event{Start: start, End: start, Recurrence: parseRRULE(rrule.Value)}
+		for _, onset := range domain.ExpandRecurrence(series, start, transitionsEnd) {
+			o.onsets = append(o.onsets, onset.Start)
+		}
+	} else {
+		o.onsets = append(o.onsets, start)
+	}
+	for _, prop := range component.Properties {
+		if prop.IANAToken != string(ics.PropertyRdate) {
+			continue
+		}
+		for _, value := range strings.Split(prop.Value, ",") {
+			if onset, err := time.ParseInLocation("20060102T150405", value, before); err == nil {
+				o.onsets = append(o.onsets, onset)
+			}
+		}
+	}
+	return o, true
+}
+
+// transitionZone builds a location changing offsets at the onsets of
+// observances, by encoding them as TZif data
+func transitionZone(name string, observances []observance) (*time.Location, error) {
+	type transition struct {
+		at int64
+		o  *observance
+	}
+	var transitions []transition
+	for i := range observances {
+		for _, onset := range observances[i].onsets {
+			transitions = append(transitions, transition{onset.Unix(), &observances[i]})
+		}
+	}
+	if len(transitions) == 0 {
+		return nil, fmt.Errorf("time zone %s has no transitions", name)
+	}
+	sort.Slice(transitions, func(i, j int) bool { return transitions[i].at < transitions[j].at })
+
+	// The first local time type applies before the first transition
+	type localType struct {
+		offset int
+		dst    bool
+		name   string
+	}
+	initial := localType{offset: transitions[0].o.offsetFrom}
+	for _, o := range observances {
+		if !o.dst && o.offsetTo == initial.offset {
+			initial.name = o.name
+			break
+		}
+	}
+	types := []localType{initial}
+	indexOf := func(t localType) int {
+		for i, existing := range types {
+			if existing == t {
+				return i
+			}
+		}
+		types = append(types, t)
+		return len(types) - 1
+	}
+	indexes := make([]int, len(transitions))
+	for i, tr := range transitions {
+		indexes[i] = indexOf(localType{tr.o.offsetTo, tr.o.dst, tr.o.name})
+	}
+
+	var abbreviations bytes.Buffer
+	abbreviationAt := make([]int, len(types))
+	for i, t := range types {
+		abbreviationAt[i] = abbreviations.Len()
+		abbreviations.WriteString(t.name)
+		abbreviations.WriteByte(0)
+	}
+	if len(types) > 255 || abbreviationAt[len(types)-1] > 255 {
+		return nil, fmt.Errorf("time zone %s has too many observances", name)
+	}
+
+	var data bytes.Buffer
+	header := func(transitions, types, chars int) {
+		data.WriteString("TZif2")
+		data.Write(make([]byte, 15))
+		for _, n := range []int{0, 0, 0, transitions, types, chars} {
+			binary.Write(&data, binary.BigEndian, uint32(n))
+		}
+	}
+	// Readers skip the 32-bit block of version 2 data, so it is left empty
+	header(0, 0, 0)
+	header(len(transitions), len(types), abbreviations.Len())
+	for _, tr := range transitions {
+		binary.Write(&data, binary.BigEndian, tr.at)
+	}
+	for _, index := range indexes {
+		data.WriteByte(byte(index))
+	}
+	for i, t := range types {
+		binary.Write(&data, binary.BigEndian, int32(t.offset))
+		if t.dst {
+			data.WriteByte(1)
+		} else {
+			data.WriteByte(0)
+		}
+		data.WriteByte(byte(abbreviationAt[i]))
+	}
+	data.Write(abbreviations.Bytes())
+
+	return time.LoadLocationFromTZData(name, data.Bytes())
+}
+
+// standardOffset returns the TZOFFSETTO of the first STANDARD observance
+func standardOffset(tz *ics.VTimezone) (int, bool) {
+	for _, component := range tz.Components {
+		standard, ok := component.(*ics.Standard)
+		if !ok {
+			continue
+		}
+		if prop := standard.GetProperty(ics.ComponentProperty(ics.PropertyTzoffsetto)); prop != nil {
+			if offset, err := parseOffset(prop.Value); err == nil {
+				return offset, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// buildTimezone describes loc as a VTIMEZONE. Each transition found in year
+// becomes an observance with a yearly rule, which covers zones that follow
+// "n-th weekday of the month" daylight saving rules.
+func buildTimezone(loc *time.Location, year int) *ics.VTimezone {
+	tz := ics.NewTimezone(zoneName(loc))
+
+	yearStart := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	yearEnd := yearStart.AddDate(1, 0, 0)
+
+	for t := yearStart; ; {
+		_, end := t.ZoneBounds()
+		if end.IsZero() || !end.Before(yearEnd) {
+			break
+		}
+		addObservance(tz, end)
+		t = end
+	}
+
+	if len(tz.Components) == 0 {
+		name, offset := yearStart.Zone()
+		standard := tz.AddStandard()
+		standard.AddProperty(ics.ComponentProperty(ics.PropertyDtstart), "19700101T000000")
+		standard.AddProperty(ics.ComponentProperty(ics.PropertyTzoffsetfrom), formatOffset(offset))
+		standard.AddProperty(ics.ComponentProperty(ics.PropertyTzoffsetto), formatOffset(offset))
+		standard.AddProperty(ics.ComponentProperty(ics.PropertyTzname), name)
+	}
+
+	return tz
+}
+
+func addObservance(tz *ics.VTimezone, transition time.Time) {
+	_, offsetFrom := transition.Add(-time.Second).Zone()
+	name, offsetTo := transition.Zone()
+
+	// DTSTART is the local time of the onset in the previous offset
+	onset := transition.UTC().Add(time.Duration(offsetFrom) * time.Second)
+
+	weekOfMonth := (onset.Day()-1)/7 + 1
+	if onset.Day()+7 > time.Date(onset.Year(), onset.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day() {
+		weekOfMonth = -1
+	}
+	rrule := fmt.Sprintf("FREQ=YEARLY;BYMONTH=%d;BYDAY=%d%s", int(onset.Month()), weekOfMonth, weekdayCode(onset.Weekday()))
+
+	observance := ics.ComponentBase{}
+	observance.AddProperty(ics.ComponentProperty(ics.PropertyDtstart), onset.Format("20060102T150405"))
+	observance.AddProperty(ics.ComponentProperty(ics.PropertyRrule), rrule)
+	observance.AddProperty(ics.ComponentProperty(ics.PropertyTzoffsetfrom), formatOffset(offsetFrom))
+	observance.AddProperty(ics.ComponentProperty(ics.PropertyTzoffsetto), formatOffset(offsetTo))
+	observance.AddProperty(ics.ComponentProperty(ics.PropertyTzname), name)
+
+	if transition.IsDST() {
+		tz.Components = append(tz.Components, &ics.Daylight{ComponentBase: observance})
+	} else {
+		tz.Components = append(tz.Components, &ics.Standard{ComponentBase: observance})
+	}
+}
+
+// formatOffset renders a UTC offset in seconds as "+HHMM" (or "+HHMMSS")
+func formatOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	s := fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset%3600/60)
+	if offset%60 != 0 {
+		s += fmt.Sprintf("%02d", offset%60)
+	}
+	return s
+}
+
+// parseOffset parses a UTC offset such as "+0100" or "-053000" into seconds
+func parseOffset(value string) (int, error) {
+	if len(value) != 5 && len(value) != 7 {
+		return 0, fmt.Errorf("invalid UTC offset: %s", value)
+	}
+
+	sign := 1
+	switch value[0] {
+	case '+':
+	case '-':
+		sign = -1
+	default:
+		return 0, fmt.Errorf("invalid UTC offset: %s", value)
+	}
+
+	var fields []int
+	for i := 1; i < len(value); i += 2 {
+		n, err := strconv.Atoi(value[i : i+2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid UTC offset: %s", value)
+		}
+		fields = append(fields, n)
+	}
+	for len(fields) < 3 {
+		fields = append(fields, 0)
+	}
+
+	return sign * (fields[0]*3600 + fields[1]*60 + fields[2]), nil
+}
+
+// zoneName returns the TZID to write for loc, or "" when times in loc should
+// be written in UTC because the zone has no portable name. The system zone
+// is written by its IANA name so local series keep their wall clock time.
+func zoneName(loc *time.Location) string {
+	switch name := loc.String(); name {
+	case "UTC", "":
+		return ""
+	case "Local":
+		return localZoneName()
+	default:
+		return name
+	}
+}
+
+// utcZones are the IANA names of UTC, written as UTC times
+var utcZones = map[string]bool{"UTC": true, "Etc/UTC": true, "UCT": true, "Etc/UCT": true, "Universal": true, "Etc/Universal": true, "Zulu": true, "Etc/Zulu": true}
+
+// localZones caches the IANA names of the system zone by the value of $TZ
+var localZones sync.Map
+
+// localZoneName returns the IANA name of the zone time.Local was loaded
+// from, $TZ or the target of /etc/localtime, or "" when it has none
+func localZoneName() string {
+	tz, set := os.LookupEnv("TZ")
+	key := tz
+	if !set {
+		key = "\x00unset"
+	}
+	if name, ok := localZones.Load(key); ok {
+		return name.(string)
+	}
+
+	name := systemZoneName(tz, set, "/etc/localtime")
+	localZones.Store(key, name)
+	return name
+}
+
+// systemZoneName resolves the zone named by tz, or by the link at
+// localtime when tz is not set, to an IANA name that time.LoadLocation
+// accepts. An empty tz means UTC, as it does for time.Local.
+func systemZoneName(tz string, set bool, localtime string) string {
+	if !set {
+		target, err := os.Readlink(localtime)
+		if err != nil {
+			return ""
+		}
+		tz = target
+	}
+
+	tz = strings.TrimPrefix(tz, ":")
+	if i := strings.LastIndex(tz, "zoneinfo/"); i >= 0 {
+		tz = tz[i+len("zoneinfo/"):]
+	}
+	if tz == "" || utcZones[tz] || filepath.IsAbs(tz) {
+		return ""
+	}
+	if _, err := time.LoadLocation(tz); err != nil {
+		return ""
+	}
+	return tz
+}
@@ -0,0 +1,399 @@
+package ical
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	return loc
+}
+
+func TestParseEvents_TimeZones(t *testing.T) {
+	berlin := loadLocation(t, "Europe/Berlin")
+
+	icsData := `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Test//Test//EN
+BEGIN:VTIMEZONE
+TZID:Custom Standard Time
+BEGIN:STANDARD
+DTSTART:16010101T000000
+TZOFFSETFROM:+0530
+TZOFFSETTO:+0530
+END:STANDARD
+END:VTIMEZONE
+BEGIN:VEVENT
+UID:tzid
+SUMMARY:Berlin
+DTSTART;TZID=Europe/Berlin:20250901T100000
+DTEND;TZID=Europe/Berlin:20250901T110000
+END:VEVENT
+BEGIN:VEVENT
+UID:prefixed
+SUMMARY:Prefixed
+DTSTART;TZID=/mozilla.org/20050126_1/Europe/Berlin:20250901T100000
+DTEND;TZID=/mozilla.org/20050126_1/Europe/Berlin:20250901T110000
+END:VEVENT
+BEGIN:VEVENT
+UID:declared
+SUMMARY:Declared
+DTSTART;TZID=Custom Standard Time:20250901T100000
+DTEND;TZID=Custom Standard Time:20250901T110000
+END:VEVENT
+BEGIN:VEVENT
+UID:floating
+SUMMARY:Floating
+DTSTART:20250901T100000
+DTEND:20250901T110000
+END:VEVENT
+BEGIN:VEVENT
+UID:utc
+SUMMARY:UTC
+DTSTART:20250901T100000Z
+DTEND:20250901T110000Z
+END:VEVENT
+END:VCALENDAR`
+
+	events, err := ParseEvents(strings.NewReader(icsData))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(events) != 5 {
+		t.Fatalf("expected 5 events, got %d", len(events))
+	}
+
+	for _, e := range events[:2] {
+		if e.Start.Location().String() != berlin.String() {
+			t.Errorf("%s: expected Europe/Berlin, got %v", e.UID, e.Start.Location())
+		}
+		if !e.Start.Equal(time.Date(2025, 9, 1, 8, 0, 0, 0, time.UTC)) {
+			t.Errorf("%s: expected 08:00 UTC, got %v", e.UID, e.Start.UTC())
+		}
+	}
+
+	if _, offset := events[2].Start.Zone(); offset != 5*3600+30*60 {
+		t.Errorf("expected VTIMEZONE offset +05:30, got %d", offset)
+	}
+
+	if !events[3].Floating || events[3].Start.Hour() != 10 {
+		t.Errorf("expected floating event at 10:00, got %+v", events[3])
+	}
+
+	if events[4].Floating || events[4].Start.Location() != time.UTC {
+		t.Errorf("expected UTC event, got %+v", events[4])
+	}
+}
+
+func TestParseEvents_DeclaredDaylightSaving(t *testing.T) {
+	icsData := `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Test//Test//EN
+BEGIN:VTIMEZONE
+TZID:W. Europe Standard Time
+BEGIN:STANDARD
+DTSTART:16010101T030000
+TZOFFSETFROM:+0200
+TZOFFSETTO:+0100
+RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=10
+END:STANDARD
+BEGIN:DAYLIGHT
+DTSTART:16010101T020000
+TZOFFSETFROM:+0100
+TZOFFSETTO:+0200
+RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=3
+END:DAYLIGHT
+END:VTIMEZONE
+BEGIN:VTIMEZONE
+TZID:Eastern
+BEGIN:STANDARD
+DTSTART:19671029T020000
+RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=10;UNTIL=20061029T060000Z
+TZOFFSETFROM:-0400
+TZOFFSETTO:-0500
+TZNAME:EST
+END:STANDARD
+BEGIN:DAYLIGHT
+DTSTART:19870405T020000
+RRULE:FREQ=YEARLY;BYDAY=1SU;BYMONTH=4;UNTIL=20060402T070000Z
+TZOFFSETFROM:-0500
+TZOFFSETTO:-0400
+TZNAME:EDT
+END:DAYLIGHT
+BEGIN:DAYLIGHT
+DTSTART:20070311T020000
+RRULE:FREQ=YEARLY;BYDAY=2SU;BYMONTH=3
+TZOFFSETFROM:-0500
+TZOFFSETTO:-0400
+TZNAME:EDT
+END:DAYLIGHT
+BEGIN:STANDARD
+DTSTART:20071104T020000
+RRULE:FREQ=YEARLY;BYDAY=1SU;BYMONTH=11
+TZOFFSETFROM:-0400
+TZOFFSETTO:-0500
+TZNAME:EST
+END:STANDARD
+END:VTIMEZONE
+BEGIN:VTIMEZONE
+TZID:Listed
+BEGIN:STANDARD
+DTSTART:20251026T030000
+TZOFFSETFROM:+0200
+TZOFFSETTO:+0100
+END:STANDARD
+BEGIN:DAYLIGHT
+DTSTART:20250330T020000
+RDATE:20250330T020000,20260329T020000
+TZOFFSETFROM:+0100
+TZOFFSETTO:+0200
+END:DAYLIGHT
+END:VTIMEZONE
+BEGIN:VEVENT
+UID:summer
+DTSTART;TZID=W. Europe Standard Time:20250701T100000
+END:VEVENT
+BEGIN:VEVENT
+UID:winter
+DTSTART;TZID=W. Europe Standard Time:20251201T100000
+END:VEVENT
+BEGIN:VEVENT
+UID:before-change
+DTSTART;TZID=W. Europe Standard Time:20251026T013000
+END:VEVENT
+BEGIN:VEVENT
+UID:after-change
+DTSTART;TZID=W. Europe Standard Time:20251026T033000
+END:VEVENT
+BEGIN:VEVENT
+UID:old-rule
+DTSTART;TZID=Eastern:20050405T100000
+END:VEVENT
+BEGIN:VEVENT
+UID:new-rule
+DTSTART;TZID=Eastern:20250312T100000
+END:VEVENT
+BEGIN:VEVENT
+UID:rdate
+DTSTART;TZID=Listed:20260601T100000
+END:VEVENT
+END:VCALENDAR`
+
+	events, err := ParseEvents(strings.NewReader(icsData))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := map[string]time.Time{
+		"summer":        time.Date(2025, 7, 1, 8, 0, 0, 0, time.UTC),
+		"winter":        time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC),
+		"before-change": time.Date(2025, 10, 25, 23, 30, 0, 0, time.UTC),
+		"after-change":  time.Date(2025, 10, 26, 2, 30, 0, 0, time.UTC),
+		"old-rule":      time.Date(2005, 4, 5, 14, 0, 0, 0, time.UTC),
+		"new-rule":      time.Date(2025, 3, 12, 14, 0, 0, 0, time.UTC),
+		"rdate":         time.Date(2026, 6, 1, 8, 0, 0, 0, time.UTC),
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("expected %d events, got %d", len(expected), len(events))
+	}
+	for _, e := range events {
+		if !e.Start.Equal(expected[e.UID]) {
+			t.Errorf("%s: expected %v, got %v", e.UID, expected[e.UID], e.Start.UTC())
+		}
+	}
+	if name, _ := events[5].Start.Zone(); name != "EDT" {
+		t.Errorf("expected the EDT abbreviation, got %q", name)
+	}
+}
+
+func TestGenerateEvent_TimeZones(t *testing.T) {
+	newYork := loadLocation(t, "America/New_York")
+
+	t.Run("named zone", func(t *testing.T) {
+		event := domain.Event{
+			UID:        "ny",
+			Summary:    "Standup",
+			Start:      time.Date(2025, 3, 3, 9, 0, 0, 0, newYork),
+			End:        time.Date(2025, 3, 3, 9, 15, 0, 0, newYork),
+			Recurrence: &domain.Recurrence{Frequency: "WEEKLY", Interval: 1},
+		}
+
+		var buf bytes.Buffer
+		if err := GenerateEvent(event, &buf); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		output := buf.String()
+		for _, want := range []string{
+			"BEGIN:VTIMEZONE",
+			"TZID:America/New_York",
+			"BEGIN:DAYLIGHT",
+			"RRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=2SU",
+			"TZOFFSETTO:-0400",
+			"BEGIN:STANDARD",
+			"RRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=1SU",
+			"DTSTART;TZID=America/New_York:20250303T090000",
+		} {
+			if !strings.Contains(output, want) {
+				t.Errorf("output should contain %q\nGot output:\n%s", want, output)
+			}
+		}
+		if strings.Index(output, "BEGIN:VTIMEZONE") > strings.Index(output, "BEGIN:VEVENT") {
+			t.Error("VTIMEZONE should precede VEVENT")
+		}
+
+		parsed, err := ParseEvents(strings.NewReader(output))
+		if err != nil || len(parsed) != 1 {
+			t.Fatalf("failed to parse generated output: %v", err)
+		}
+		if parsed[0].Start.Location().String() != "America/New_York" || !parsed[0].Start.Equal(event.Start) {
+			t.Errorf("round trip lost zone: %v", parsed[0].Start)
+		}
+	})
+
+	t.Run("floating", func(t *testing.T) {
+		event := domain.Event{
+			UID:      "floating",
+			Summary:  "Lunch",
+			Start:    time.Date(2025, 3, 3, 12, 0, 0, 0, time.Local),
+			End:      time.Date(2025, 3, 3, 13, 0, 0, 0, time.Local),
+			Floating: true,
+		}
+
+		var buf bytes.Buffer
+		if err := GenerateEvent(event, &buf); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		output := buf.String()
+		if !strings.Contains(output, "DTSTART:20250303T120000") || strings.Contains(output, "T120000Z") {
+			t.Errorf("expected floating DTSTART, got:\n%s", output)
+		}
+		if strings.Contains(output, "VTIMEZONE") {
+			t.Errorf("floating events need no VTIMEZONE, got:\n%s", output)
+		}
+	})
+}
+
+func TestOffsets(t *testing.T) {
+	tests := []struct {
+		value  string
+		offset int
+	}{
+		{"+0100", 3600},
+		{"-0500", -5 * 3600},
+		{"+0530", 5*3600 + 30*60},
+		{"+012345", 3600 + 23*60 + 45},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			offset, err := parseOffset(tt.value)
+			if err != nil || offset != tt.offset {
+				t.Errorf("parseOffset(%q) = %d, %v; want %d", tt.value, offset, err, tt.offset)
+			}
+			if got := formatOffset(tt.offset); got != tt.value {
+				t.Errorf("formatOffset(%d) = %q, want %q", tt.offset, got, tt.value)
+			}
+		})
+	}
+
+	if _, err := parseOffset("0100"); err == nil {
+		t.Error("expected error for offset without sign")
+	}
+}
+
+func TestSystemZoneName(t *testing.T) {
+	if _, err := time.LoadLocation("America/New_York"); err != nil {
+		t.Skip("tzdata not available")
+	}
+	dir := t.TempDir()
+	link := func(name, target string) string {
+		path := filepath.Join(dir, name)
+		if err := os.Symlink(target, path); err != nil {
+			t.Fatalf("failed to create symlink: %v", err)
+		}
+		return path
+	}
+	newYork := link("new-york", "../usr/share/zoneinfo/America/New_York")
+	utc := link("utc", "/usr/share/zoneinfo/Etc/UTC")
+
+	tests := []struct {
+		name      string
+		tz        string
+		set       bool
+		localtime string
+		want      string
+	}{
+		{name: "TZ", tz: "America/New_York", set: true, want: "America/New_York"},
+		{name: "TZ with colon", tz: ":Asia/Seoul", set: true, want: "Asia/Seoul"},
+		{name: "TZ as path", tz: "/usr/share/zoneinfo/Europe/Berlin", set: true, want: "Europe/Berlin"},
+		{name: "empty TZ is UTC", tz: "", set: true, localtime: newYork, want: ""},
+		{name: "unknown TZ", tz: "Nowhere/Town", set: true, want: ""},
+		{name: "localtime link", localtime: newYork, want: "America/New_York"},
+		{name: "UTC link", localtime: utc, want: ""},
+		{name: "no link", localtime: filepath.Join(dir, "missing"), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := systemZoneName(tt.tz, tt.set, tt.localtime); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGenerateEvents_LocalZoneKeepsWallClock(t *testing.T) {
+	if _, err := time.LoadLocation("America/New_York"); err != nil {
+		t.Skip("tzdata not available")
+	}
+	t.Setenv("TZ", "America/New_York")
+	local := time.Local
+	defer func() { time.Local = local }()
+	time.Local = time.FixedZone("Local", -5*3600)
+
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.Local)
+	event := domain.Event{
+		UID:        "weekly-1",
+		Summary:    "Sync",
+		Start:      start,
+		End:        start.Add(time.Hour),
+		Recurrence: &domain.Recurrence{Frequency: "WEEKLY"},
+	}
+
+	var buf bytes.Buffer
+	if err := GenerateEvents([]domain.Event{event}, &buf); err != nil {
+		t.Fatalf("failed to generate: %v", err)
+	}
+	output := strings.ReplaceAll(buf.String(), "\r\n", "\n")
+	for _, want := range []string{"TZID:America/New_York\n", "DTSTART;TZID=America/New_York:20250106T090000\n"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output:\n%s", want, output)
+		}
+	}
+
+	events, err := ParseEvents(strings.NewReader(buf.String()))
+	if err != nil || len(events) != 1 {
+		t.Fatalf("failed to parse generated event: %v", err)
+	}
+	after := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	instances := domain.ExpandRecurrence(events[0], after, after.AddDate(0, 0, 7))
+	if len(instances) == 0 {
+		t.Fatal("expected an occurrence after the change to daylight saving time")
+	}
+	if got := instances[0].Start; got.Hour() != 9 || got.Location().String() != "America/New_York" {
+		t.Errorf("expected the series to stay at 09:00 New York time, got %v", got)
+	}
+}
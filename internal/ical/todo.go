@@ -0,0 +1,186 @@
+package ical
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+
+	"github.com/arran4/golang-ical"
+)
+
+func convertToDomainTodo(todo *ics.VTodo, zones *zoneResolver) domain.Todo {
+	domainTodo := domain.Todo{}
+
+	if uid := todo.GetProperty(ics.ComponentProperty(ics.PropertyUid)); uid != nil {
+		domainTodo.UID = uid.Value
+	}
+
+	if summary := todo.GetProperty(ics.ComponentProperty(ics.PropertySummary)); summary != nil {
+		domainTodo.Summary = summary.Value
+	}
+
+	if desc := todo.GetProperty(ics.ComponentProperty(ics.PropertyDescription)); desc != nil {
+		domainTodo.Description = desc.Value
+	}
+
+	// DTSTART and DUE share their value type, so either tells whether the
+	// todo is all-day or floating
+	for _, name := range []ics.Property{ics.PropertyDtstart, ics.PropertyDue} {
+		prop := todo.GetProperty(ics.ComponentProperty(name))
+		if prop == nil {
+			continue
+		}
+		t, err := parseDateTime(prop.Value, zones.location(prop))
+		if err != nil {
+			continue
+		}
+		if name == ics.PropertyDtstart {
+			domainTodo.Start = &t
+		} else {
+			domainTodo.Due = &t
+		}
+		domainTodo.AllDay = isDate(prop)
+		domainTodo.Floating = isFloating(prop)
+	}
+
+	if priority := todo.GetProperty(ics.ComponentProperty(ics.PropertyPriority)); priority != nil {
+		domainTodo.Priority, _ = strconv.Atoi(strings.TrimSpace(priority.Value))
+	}
+
+	if status := todo.GetProperty(ics.ComponentProperty(ics.PropertyStatus)); status != nil {
+		domainTodo.Status = strings.ToUpper(status.Value)
+	}
+
+	if percent := todo.GetProperty(ics.ComponentProperty(ics.PropertyPercentComplete)); percent != nil {
+		domainTodo.PercentComplete, _ = strconv.Atoi(strings.TrimSpace(percent.Value))
+	}
+
+	if completed := todo.GetProperty(ics.ComponentProperty(ics.PropertyCompleted)); completed != nil {
+		if t, err := parseDateTime(completed.Value, zones.location(completed)); err == nil {
+			domainTodo.Completed = &t
+		}
+	}
+
+	if rrule := todo.GetProperty(ics.ComponentProperty(ics.PropertyRrule)); rrule != nil {
+		domainTodo.Recurrence = parseRRULE(rrule.Value)
+	}
+
+	return domainTodo
+}
+
+// addTodo adds todo to cal, patching its source like addEvent
+func addTodo(cal *ics.Calendar, todo domain.Todo) {
+	vtodo := newVTodo(todo)
+	if lines, ok := patchTodoSource(todo, vtodo); ok {
+		cal.Components = append(cal.Components, &rawComponent{lines: lines})
+		return
+	}
+	cal.AddVTodo(vtodo)
+}
+
+// newVTodo builds a VTODO holding the properties calcli models
+func newVTodo(todo domain.Todo) *ics.VTodo {
+	vtodo := ics.NewTodo(todo.UID)
+	vtodo.SetSummary(todo.Summary)
+
+	if todo.Description != "" {
+		vtodo.SetDescription(todo.Description)
+	}
+
+	if todo.Start != nil {
+		value, params := formatDateList([]time.Time{*todo.Start}, todoDates(todo))
+		vtodo.SetProperty(ics.ComponentProperty(ics.PropertyDtstart), value, params...)
+	}
+
+	if todo.Due != nil {
+		value, params := formatDateList([]time.Time{*todo.Due}, todoDates(todo))
+		vtodo.SetProperty(ics.ComponentProperty(ics.PropertyDue), value, params...)
+	}
+
+	if todo.Priority != 0 {
+		vtodo.SetPriority(todo.Priority)
+	}
+
+	if todo.Status != "" {
+		vtodo.SetProperty(ics.ComponentProperty(ics.PropertyStatus), todo.Status)
+	}
+
+	if todo.PercentComplete != 0 {
+		vtodo.SetPercentComplete(todo.PercentComplete)
+	}
+
+	// COMPLETED is always written in UTC
+	if todo.Completed != nil {
+		vtodo.SetCompletedAt(*todo.Completed)
+	}
+
+	if todo.Recurrence != nil {
+		vtodo.SetProperty(ics.ComponentProperty(ics.PropertyRrule), FormatRRULE(todo.Recurrence, todoDates(todo)))
+	}
+
+	return vtodo
+}
+
+// todoDates returns an event carrying the value type and zone the dates of
+// todo are written in, for formatDateList
+func todoDates(todo domain.Todo) domain.Event {
+	event := domain.Event{AllDay: todo.AllDay, Floating: todo.Floating}
+	switch {
+	case todo.Due != nil:
+		event.Start = *todo.Due
+	case todo.Start != nil:
+		event.Start = *todo.Start
+	}
+	return event
+}
+
+// patchTodoSource returns the source lines of a parsed todo with the
+// properties that changed replaced, like patchSource does for events
+func patchTodoSource(todo domain.Todo, vtodo *ics.VTodo) ([]string, bool) {
+	if todo.Source == "" {
+		return nil, false
+	}
+
+	cal, err := parseSource(todo.Source, todo.SourceZones)
+	if err != nil || len(cal.Todos()) != 1 {
+		return nil, false
+	}
+	source := cal.Todos()[0]
+	original := convertToDomainTodo(source, newZoneResolver(cal))
+
+	changed := changedTodoItems(original, todo, source)
+	return patchLines(todo.Source, vtodo.Serialize(rawConfig), changed, nil), true
+}
+
+// changedTodoItems lists the properties of original that must be rewritten
+// to describe todo
+func changedTodoItems(original, todo domain.Todo, source *ics.VTodo) []string {
+	var changed []string
+	add := func(differs bool, names ...string) {
+		if differs {
+			changed = append(changed, names...)
+		}
+	}
+
+	_, params := formatDateList(nil, todoDates(todo))
+	reformat := original.AllDay != todo.AllDay || original.Floating != todo.Floating
+	for _, name := range []ics.Property{ics.PropertyDtstart, ics.PropertyDue} {
+		if prop := source.GetProperty(ics.ComponentProperty(name)); prop != nil && paramValue(prop, ics.ParameterTzid) != tzidOf(params) {
+			reformat = true
+		}
+	}
+
+	add(original.UID != todo.UID, "UID")
+	add(original.Summary != todo.Summary, "SUMMARY")
+	add(original.Description != todo.Description, "DESCRIPTION")
+	add(reformat || !equalTimes(optionalTime(original.Start), optionalTime(todo.Start)), "DTSTART")
+	add(reformat || !equalTimes(optionalTime(original.Due), optionalTime(todo.Due)), "DUE")
+	add(original.Priority != todo.Priority, "PRIORITY")
+	add(original.Status != todo.Status, "STATUS")
+	add(original.PercentComplete != todo.PercentComplete, "PERCENT-COMPLETE")
+	add(!equalTimes(optionalTime(original.Completed), optionalTime(todo.Completed)), "COMPLETED")
+	add(FormatRRULE(original.Recurrence, todoDates(original)) != FormatRRULE(todo.Recurrence, todoDates(todo)), "RRULE")
+	return changed
+}
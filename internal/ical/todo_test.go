@@ -0,0 +1,153 @@
+package ical
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+const tasksCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Nextcloud Tasks v0.16.1\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:event-1\r\n" +
+	"SUMMARY:Review\r\n" +
+	"DTSTART:20250901T100000Z\r\n" +
+	"DTEND:20250901T110000Z\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VTODO\r\n" +
+	"UID:todo-1\r\n" +
+	"CREATED:20250801T080000Z\r\n" +
+	"SUMMARY:Water the plants\r\n" +
+	"DUE;VALUE=DATE:20250905\r\n" +
+	"PRIORITY:1\r\n" +
+	"STATUS:needs-action\r\n" +
+	"PERCENT-COMPLETE:20\r\n" +
+	"RRULE:FREQ=WEEKLY\r\n" +
+	"X-APPLE-SORT-ORDER:3\r\n" +
+	"END:VTODO\r\n" +
+	"BEGIN:VTODO\r\n" +
+	"UID:todo-2\r\n" +
+	"SUMMARY:File taxes\r\n" +
+	"DTSTART:20250901T090000Z\r\n" +
+	"DUE:20250915T170000Z\r\n" +
+	"STATUS:COMPLETED\r\n" +
+	"COMPLETED:20250910T120000Z\r\n" +
+	"END:VTODO\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseObjects_Todos(t *testing.T) {
+	objects, err := ParseObjects(strings.NewReader(tasksCalendar))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if len(objects.Events) != 1 {
+		t.Errorf("expected 1 event, got %d", len(objects.Events))
+	}
+	if len(objects.Todos) != 2 {
+		t.Fatalf("expected 2 todos, got %d", len(objects.Todos))
+	}
+
+	plants := objects.Todos[0]
+	if plants.Summary != "Water the plants" || plants.Priority != 1 || plants.PercentComplete != 20 {
+		t.Errorf("unexpected todo %+v", plants)
+	}
+	if plants.Status != domain.TodoNeedsAction {
+		t.Errorf("expected status to be upper-cased, got %q", plants.Status)
+	}
+	if !plants.AllDay || plants.Due == nil || plants.Due.Format("2006-01-02") != "2025-09-05" {
+		t.Errorf("expected an all-day due date of 2025-09-05, got %v (all-day %v)", plants.Due, plants.AllDay)
+	}
+	if plants.Recurrence == nil || plants.Recurrence.Frequency != "WEEKLY" {
+		t.Errorf("expected a weekly recurrence, got %+v", plants.Recurrence)
+	}
+
+	taxes := objects.Todos[1]
+	if taxes.Start == nil || !taxes.Start.Equal(time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected start %v", taxes.Start)
+	}
+	if !taxes.IsDone() || taxes.Completed == nil || !taxes.Completed.Equal(time.Date(2025, 9, 10, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected a completed todo, got %+v", taxes)
+	}
+}
+
+func TestGenerateObjects_Todos(t *testing.T) {
+	objects, err := ParseObjects(strings.NewReader(tasksCalendar))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		edit        func(todo domain.Todo) domain.Todo
+		wantLines   []string
+		unwantLines []string
+	}{
+		{
+			name:      "unchanged todo",
+			edit:      func(todo domain.Todo) domain.Todo { return todo },
+			wantLines: []string{"STATUS:needs-action", "DUE;VALUE=DATE:20250905", "X-APPLE-SORT-ORDER:3", "CREATED:20250801T080000Z"},
+		},
+		{
+			name:        "completed",
+			edit:        func(todo domain.Todo) domain.Todo { return todo.Complete(time.Date(2025, 9, 5, 18, 0, 0, 0, time.UTC)) },
+			wantLines:   []string{"DUE;VALUE=DATE:20250912", "X-APPLE-SORT-ORDER:3", "PRIORITY:1"},
+			unwantLines: []string{"PERCENT-COMPLETE", "DUE;VALUE=DATE:20250905"},
+		},
+		{
+			name: "new todo",
+			edit: func(todo domain.Todo) domain.Todo {
+				due := time.Date(2025, 9, 20, 17, 0, 0, 0, time.UTC)
+				return domain.Todo{UID: "todo-3", Summary: "Book flights", Due: &due, Priority: 5, Status: domain.TodoInProcess}
+			},
+			wantLines:   []string{"UID:todo-3", "SUMMARY:Book flights", "DUE:20250920T170000Z", "PRIORITY:5", "STATUS:IN-PROCESS"},
+			unwantLines: []string{"DTSTART", "COMPLETED"},
+		},
+		{
+			name: "repeating all-day todo",
+			edit: func(todo domain.Todo) domain.Todo {
+				due := time.Date(2025, 9, 20, 0, 0, 0, 0, time.FixedZone("CEST", 2*3600))
+				until := due.AddDate(0, 1, 0)
+				return domain.Todo{UID: "todo-4", Summary: "Water plants", Due: &due, AllDay: true,
+					Recurrence: &domain.Recurrence{Frequency: "WEEKLY", Interval: 1, Until: &until}}
+			},
+			wantLines:   []string{"DUE;VALUE=DATE:20250920", "RRULE:FREQ=WEEKLY;UNTIL=20251020"},
+			unwantLines: []string{"UNTIL=20251019T220000Z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todo := tt.edit(objects.Todos[0])
+
+			var buf bytes.Buffer
+			if err := GenerateObjects(Objects{Todos: []domain.Todo{todo}}, &buf); err != nil {
+				t.Fatalf("failed to generate: %v", err)
+			}
+			lines := unfoldLines(buf.String())
+
+			for _, want := range tt.wantLines {
+				if !slices.Contains(lines, want) {
+					t.Errorf("expected line %q in output:\n%s", want, buf.String())
+				}
+			}
+			for _, unwant := range tt.unwantLines {
+				if strings.Contains(buf.String(), unwant) {
+					t.Errorf("did not expect %q in output:\n%s", unwant, buf.String())
+				}
+			}
+
+			reparsed, err := ParseObjects(&buf)
+			if err != nil {
+				t.Fatalf("failed to reparse: %v", err)
+			}
+			if len(reparsed.Todos) != 1 || reparsed.Todos[0].Summary != todo.Summary || reparsed.Todos[0].Status != todo.Status {
+				t.Errorf("expected todo %+v after round-trip, got %+v", todo, reparsed.Todos)
+			}
+		})
+	}
+}
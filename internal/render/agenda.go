@@ -0,0 +1,137 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+// OngoingMarker flags the events in progress in an agenda
+const OngoingMarker = ">"
+
+// Agenda renders the events of a range of days under a heading per day
+type Agenda struct {
+	From     time.Time // first day
+	Days     int
+	Events   []domain.Event
+	Now      time.Time
+	Location *time.Location    // zone used to place events on days
+	Colors   map[string]string // colors of calendars by name
+	Locale   Locale            // names of days in headings, English when zero
+}
+
+// Render writes the days that have events, all-day and multi-day events
+// first, then timed events by their start
+func (a *Agenda) Render(w io.Writer) error {
+	loc := a.Location
+	if loc == nil {
+		loc = a.From.Location()
+	}
+
+	first := time.Date(a.From.Year(), a.From.Month(), a.From.Day(), 0, 0, 0, 0, loc)
+	byDate := make(map[string][]domain.Event)
+	for _, event := range a.Events {
+		event = event.In(loc)
+		for _, day := range event.Dates() {
+			key := dateKey(day)
+			byDate[key] = append(byDate[key], event)
+		}
+	}
+
+	shown := 0
+	for i := 0; i < a.Days; i++ {
+		day := first.AddDate(0, 0, i)
+		events := byDate[dateKey(day)]
+		if len(events) == 0 {
+			continue
+		}
+		sortDayEntries(events)
+
+		if shown > 0 {
+			fmt.Fprintln(w)
+		}
+		today := dateKey(day) == dateKey(a.Now.In(loc))
+		heading := DayHeading(day, a.Now.In(loc), a.Locale)
+		if today {
+			heading = HighlightToday(heading)
+		}
+		fmt.Fprintln(w, heading)
+		for _, event := range events {
+			// Events in progress are marked under today only
+			marker := " "
+			if today && event.IsOngoing(a.Now) {
+				marker = OngoingMarker
+			}
+			fmt.Fprintf(w, "%s %s", marker, Colorize(a.Colors[event.Calendar], FormatAgendaEntry(event, day)))
+			if event.Location != "" {
+				fmt.Fprintf(w, " @ %s", event.Location)
+			}
+			fmt.Fprintln(w)
+		}
+		shown++
+	}
+
+	if shown == 0 {
+		fmt.Fprintf(w, "No events in the next %d days.\n", a.Days)
+	}
+	return nil
+}
+
+// DayHeading names day relative to today in locale, English when zero,
+// e.g. "Today, Thu 2026-10-16" or "Sat 2026-10-18"
+func DayHeading(day, today time.Time, locale Locale) string {
+	locale = locale.orEnglish()
+	date := locale.Weekday(day.Weekday()) + day.Format(" 2006-01-02")
+	switch dateKey(day) {
+	case dateKey(today):
+		return locale.Today + ", " + date
+	case dateKey(today.AddDate(0, 0, 1)):
+		return locale.Tomorrow + ", " + date
+	case dateKey(today.AddDate(0, 0, -1)):
+		return locale.Yesterday + ", " + date
+	}
+	return date
+}
+
+// FormatAgendaEntry formats an event as listed under one of the days it
+// covers, e.g. "14:00-15:00 Meeting" or "all-day Conference (2/3)"
+func FormatAgendaEntry(event domain.Event, day time.Time) string {
+	return fmt.Sprintf("%s %s%s", agendaLabel(event, day), event.Summary, spanSuffix(event, day))
+}
+
+// agendaLabel returns the time range of an event on day. Timed events
+// spanning several days show where they start and end.
+func agendaLabel(event domain.Event, day time.Time) string {
+	if event.AllDay {
+		return "all-day"
+	}
+
+	starts := dateKey(event.Start) == dateKey(day)
+	ends := dateKey(event.LastDate()) == dateKey(day)
+	switch {
+	case starts && ends:
+		return event.Start.Format("15:04") + "-" + event.EndTime().Format("15:04")
+	case starts:
+		return event.Start.Format("15:04") + "-..."
+	case ends:
+		return "...-" + event.EndTime().Format("15:04")
+	}
+	return "..."
+}
+
+// sortDayEntries orders the events of a day: all-day and multi-day events
+// first, then timed events by their start
+func sortDayEntries(events []domain.Event) {
+	domain.SortEvents(events)
+	sort.SliceStable(events, func(i, j int) bool {
+		return spansDays(events[i]) && !spansDays(events[j])
+	})
+}
+
+// spansDays reports whether event is all-day or multi-day
+func spansDays(event domain.Event) bool {
+	return event.AllDay || event.IsMultiDay()
+}
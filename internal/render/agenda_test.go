@@ -0,0 +1,115 @@
+package render
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestAgenda_Render(t *testing.T) {
+	day := func(d, hour, minute int) time.Time {
+		return time.Date(2026, time.October, d, hour, minute, 0, 0, time.UTC)
+	}
+	events := []domain.Event{
+		{Summary: "Lunch", Start: day(16, 12, 0), End: day(16, 13, 0), Location: "Cafe"},
+		{Summary: "Standup", Start: day(16, 9, 0), End: day(16, 9, 15)},
+		{Summary: "Conference", Start: day(16, 0, 0), End: day(18, 0, 0), AllDay: true},
+		{Summary: "Night shift", Start: day(17, 22, 0), End: day(18, 6, 0)},
+		{Summary: "Retro", Start: day(20, 15, 0), End: day(20, 16, 0)},
+	}
+
+	agenda := Agenda{From: day(16, 0, 0), Days: 3, Events: events, Now: day(16, 12, 30), Location: time.UTC}
+
+	var buf bytes.Buffer
+	if err := agenda.Render(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "Today, Fri 2026-10-16\n" +
+		"> all-day Conference (1/2)\n" +
+		"  09:00-09:15 Standup\n" +
+		"> 12:00-13:00 Lunch @ Cafe\n" +
+		"\n" +
+		"Tomorrow, Sat 2026-10-17\n" +
+		"  all-day Conference (2/2)\n" +
+		"  22:00-... Night shift (1/2)\n" +
+		"\n" +
+		"Sun 2026-10-18\n" +
+		"  ...-06:00 Night shift (2/2)\n"
+	if buf.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+func TestAgenda_RenderEmpty(t *testing.T) {
+	agenda := Agenda{From: time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC), Days: 7}
+
+	var buf bytes.Buffer
+	if err := agenda.Render(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "No events in the next 7 days.\n" {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
+
+func TestDayHeading(t *testing.T) {
+	today := time.Date(2026, time.October, 16, 15, 0, 0, 0, time.UTC)
+	german, _ := LookupLocale("de")
+	korean, _ := LookupLocale("ko")
+
+	tests := []struct {
+		day    time.Time
+		locale Locale
+		want   string
+	}{
+		{time.Date(2026, time.October, 15, 0, 0, 0, 0, time.UTC), Locale{}, "Yesterday, Thu 2026-10-15"},
+		{time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC), Locale{}, "Today, Fri 2026-10-16"},
+		{time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC), Locale{}, "Tomorrow, Sat 2026-10-17"},
+		{time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC), Locale{}, "Tue 2026-10-20"},
+		{time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC), german, "Heute, Fr 2026-10-16"},
+		{time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC), german, "Di 2026-10-20"},
+		{time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC), korean, "내일, 토 2026-10-17"},
+	}
+
+	for _, tt := range tests {
+		if got := DayHeading(tt.day, today, tt.locale); got != tt.want {
+			t.Errorf("DayHeading(%v) = %q, want %q", tt.day, got, tt.want)
+		}
+	}
+}
+
+func TestAgenda_RenderColors(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(profile)
+	lipgloss.SetColorProfile(termenv.ANSI256)
+
+	day := func(d, hour int) time.Time {
+		return time.Date(2026, time.October, d, hour, 0, 0, 0, time.UTC)
+	}
+	events := []domain.Event{
+		{Summary: "Review", Calendar: "work", Start: day(16, 9), End: day(16, 10)},
+		{Summary: "Dinner", Calendar: "home", Start: day(17, 19), End: day(17, 20)},
+	}
+
+	agenda := Agenda{From: day(16, 0), Days: 2, Events: events, Now: day(16, 8), Location: time.UTC, Colors: map[string]string{"work": "blue"}}
+
+	var buf bytes.Buffer
+	if err := agenda.Render(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "\x1b[7mToday, Fri 2026-10-16\x1b[0m\n" +
+		"  \x1b[34m09:00-10:00 Review\x1b[0m\n" +
+		"\n" +
+		"Tomorrow, Sat 2026-10-17\n" +
+		"  19:00-20:00 Dinner\n"
+	if buf.String() != expected {
+		t.Errorf("expected:\n%q\ngot:\n%q", expected, buf.String())
+	}
+}
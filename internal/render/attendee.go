@@ -0,0 +1,26 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+// FormatAttendee renders an attendee with their reply, e.g.
+// "Bob <bob@example.com> (accepted)". Attendees that have not replied yet
+// are shown without one.
+func FormatAttendee(attendee domain.Attendee) string {
+	if attendee.Status == "" || attendee.Status == "NEEDS-ACTION" {
+		return attendee.String()
+	}
+	return attendee.String() + " (" + strings.ToLower(attendee.Status) + ")"
+}
+
+// FormatAttendees renders a comma separated list of attendees
+func FormatAttendees(attendees []domain.Attendee) string {
+	formatted := make([]string, len(attendees))
+	for i, attendee := range attendees {
+		formatted[i] = FormatAttendee(attendee)
+	}
+	return strings.Join(formatted, ", ")
+}
@@ -0,0 +1,42 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+func TestFormatAttendees(t *testing.T) {
+	tests := []struct {
+		name      string
+		attendees []domain.Attendee
+		want      string
+	}{
+		{
+			name:      "address only",
+			attendees: []domain.Attendee{{Email: "bob@example.com"}},
+			want:      "bob@example.com",
+		},
+		{
+			name: "names and replies",
+			attendees: []domain.Attendee{
+				{Email: "bob@example.com", Name: "Bob", Status: "ACCEPTED"},
+				{Email: "carol@example.com", Name: "Carol", Status: "NEEDS-ACTION"},
+				{Email: "dave@example.com", Status: "DECLINED"},
+			},
+			want: "Bob <bob@example.com> (accepted), Carol <carol@example.com>, dave@example.com (declined)",
+		},
+		{
+			name: "none",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatAttendees(tt.attendees); got != tt.want {
+				t.Errorf("FormatAttendees() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
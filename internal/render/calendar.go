@@ -9,70 +9,75 @@ import (
 	"github.com/NaMinhyeok/calcli/internal/domain"
 )
 
+// JournalMarker flags days with journal entries. It goes before the day
+// number, so days keep their event and today markers after it.
+const JournalMarker = "¶"
+
 // MonthView renders a month calendar grid
 type MonthView struct {
-	Year   int
-	Month  time.Month
-	Events []domain.Event
-	Today  time.Time
+	Year     int
+	Month    time.Month
+	Events   []domain.Event
+	Journals []domain.Journal // journal entries, marked on their days
+	Today    time.Time
+	Location *time.Location    // zone used to place events on days
+	Colors   map[string]string // colors of calendars by name
+
+	WeekStart       time.Weekday
+	Locale          Locale // names of months and weekdays, English when zero
+	ShowWeekNumbers bool   // ISO week numbers left of the weeks
 }
 
 // NewMonthView creates a month view for a given date
 func NewMonthView(date time.Time, events []domain.Event) *MonthView {
 	return &MonthView{
-		Year:   date.Year(),
-		Month:  date.Month(),
-		Events: events,
-		Today:  time.Now(),
+		Year:     date.Year(),
+		Month:    date.Month(),
+		Events:   events,
+		Today:    time.Now(),
+		Location: date.Location(),
 	}
 }
 
 // Render outputs the calendar to the writer
 func (m *MonthView) Render(w io.Writer) error {
+	locale := m.Locale.orEnglish()
+
 	// Header: Month Year
-	fmt.Fprintf(w, "\n  %s %d\n\n", m.Month.String(), m.Year)
+	fmt.Fprintf(w, "\n  %s\n\n", locale.FormatMonthYear(m.Month, m.Year))
 
 	// Weekday headers
-	weekdays := []string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"}
-	for _, day := range weekdays {
-		fmt.Fprintf(w, " %3s", day)
+	width := 28
+	if m.ShowWeekNumbers {
+		fmt.Fprintf(w, " %s", padLeft(locale.Week, 3))
+		width += 4
+	}
+	for _, day := range locale.orderedGridWeekdays(m.WeekStart) {
+		fmt.Fprintf(w, " %s", padLeft(day, 3))
 	}
 	fmt.Fprintln(w)
 
 	// Separator
-	fmt.Fprintln(w, strings.Repeat("-", 28))
+	fmt.Fprintln(w, strings.Repeat("-", width))
 
-	// Get first day of month and number of days
-	firstDay := time.Date(m.Year, m.Month, 1, 0, 0, 0, 0, time.UTC)
-	lastDay := firstDay.AddDate(0, 1, -1)
-	numDays := lastDay.Day()
-
-	// Starting weekday (0 = Sunday)
-	startWeekday := int(firstDay.Weekday())
-
-	// Build event map for quick lookup
+	// Build event and journal maps for quick lookup
 	eventMap := m.buildEventMap()
+	journalMap := m.buildJournalMap()
+	colorMap := dayColors(m.Events, m.Colors, m.location())
 
 	// Render calendar grid
-	currentDay := 1
-	for week := 0; week < 6; week++ { // Maximum 6 weeks
-		if currentDay > numDays {
-			break
+	for i, week := range monthWeeks(m.Year, m.Month, m.WeekStart) {
+		if m.ShowWeekNumbers {
+			fmt.Fprintf(w, " %3d", isoWeek(m.Year, m.Month, m.WeekStart, i))
 		}
-
-		for weekday := 0; weekday < 7; weekday++ {
-			if week == 0 && weekday < startWeekday {
-				// Empty cell before month starts
-				fmt.Fprintf(w, "    ")
-			} else if currentDay > numDays {
-				// Empty cell after month ends
+		for _, day := range week {
+			if day == 0 {
+				// Empty cell outside the month
 				fmt.Fprintf(w, "    ")
-			} else {
-				// Render day
-				date := time.Date(m.Year, m.Month, currentDay, 0, 0, 0, 0, time.UTC)
-				m.renderDay(w, currentDay, date, eventMap)
-				currentDay++
+				continue
 			}
+			date := time.Date(m.Year, m.Month, day, 0, 0, 0, 0, time.UTC)
+			m.renderDay(w, day, date, eventMap, journalMap, colorMap)
 		}
 		fmt.Fprintln(w)
 	}
@@ -81,24 +86,65 @@ func (m *MonthView) Render(w io.Writer) error {
 	return nil
 }
 
-func (m *MonthView) renderDay(w io.Writer, day int, date time.Time, eventMap map[string]int) {
+// monthWeeks returns the weeks of a month, starting on weekStart, as the
+// days of the month in each column; zero marks days outside the month
+func monthWeeks(year int, month time.Month, weekStart time.Weekday) [][7]int {
+	firstDay := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	numDays := firstDay.AddDate(0, 1, -1).Day()
+
+	var weeks [][7]int
+	column := weekColumn(firstDay.Weekday(), weekStart)
+	for day := 1; day <= numDays; day++ {
+		if day == 1 || column == 0 {
+			weeks = append(weeks, [7]int{})
+		}
+		weeks[len(weeks)-1][column] = day
+		column = (column + 1) % 7
+	}
+	return weeks
+}
+
+// weekColumn returns the column of day in weeks starting on weekStart
+func weekColumn(day, weekStart time.Weekday) int {
+	return (int(day) - int(weekStart) + 7) % 7
+}
+
+// isoWeek returns the ISO 8601 number of the week of a month grid in row.
+// Weeks not starting on Monday are numbered after the Monday they hold.
+func isoWeek(year int, month time.Month, weekStart time.Weekday, row int) int {
+	firstDay := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	rowStart := firstDay.AddDate(0, 0, 7*row-weekColumn(firstDay.Weekday(), weekStart))
+	monday := rowStart.AddDate(0, 0, weekColumn(time.Monday, weekStart))
+	_, week := monday.ISOWeek()
+	return week
+}
+
+func (m *MonthView) renderDay(w io.Writer, day int, date time.Time, eventMap, journalMap map[string]int, colorMap map[string]string) {
 	hasEvents := eventMap[dateKey(date)] > 0
+	hasJournal := journalMap[dateKey(date)] > 0
 	isToday := m.isToday(date)
 
+	number := fmt.Sprintf("%2d", day)
 	var marker string
 	if isToday {
+		number = HighlightToday(number)
 		marker = "*" // Today marker
 	} else if hasEvents {
-		marker = "•" // Has events
+		marker = Colorize(colorMap[dateKey(date)], "•") // Has events
 	} else {
 		marker = " "
 	}
 
-	fmt.Fprintf(w, " %2d%s", day, marker)
+	journal := " "
+	if hasJournal {
+		journal = JournalMarker
+	}
+
+	fmt.Fprintf(w, "%s%s%s", journal, number, marker)
 }
 
 func (m *MonthView) isToday(date time.Time) bool {
-	y1, m1, d1 := m.Today.Date()
+	y1, m1, d1 := m.Today.In(m.location()).Date()
 	y2, m2, d2 := date.Date()
 	return y1 == y2 && m1 == m2 && d1 == d2
 }
@@ -106,12 +152,50 @@ func (m *MonthView) isToday(date time.Time) bool {
 func (m *MonthView) buildEventMap() map[string]int {
 	eventMap := make(map[string]int)
 	for _, event := range m.Events {
-		key := dateKey(event.Start)
-		eventMap[key]++
+		for _, day := range event.In(m.location()).Dates() {
+			eventMap[dateKey(day)]++
+		}
 	}
 	return eventMap
 }
 
+// dayColors returns the color of the first event of every day, in loc,
+// whose calendar has one in colors
+func dayColors(events []domain.Event, colors map[string]string, loc *time.Location) map[string]string {
+	events = append([]domain.Event(nil), events...)
+	domain.SortEvents(events)
+
+	colorMap := make(map[string]string)
+	for _, event := range events {
+		color := colors[event.Calendar]
+		if color == "" {
+			continue
+		}
+		for _, day := range event.In(loc).Dates() {
+			if _, ok := colorMap[dateKey(day)]; !ok {
+				colorMap[dateKey(day)] = color
+			}
+		}
+	}
+	return colorMap
+}
+
+// buildJournalMap counts the journal entries of every day
+func (m *MonthView) buildJournalMap() map[string]int {
+	journalMap := make(map[string]int)
+	for _, journal := range m.Journals {
+		journalMap[dateKey(journal.Date(m.location()))]++
+	}
+	return journalMap
+}
+
+func (m *MonthView) location() *time.Location {
+	if m.Location == nil {
+		return time.Local
+	}
+	return m.Location
+}
+
 func dateKey(t time.Time) string {
 	return t.Format("2006-01-02")
 }
@@ -122,10 +206,13 @@ func (m *MonthView) RenderWithEvents(w io.Writer) error {
 		return err
 	}
 
-	// Group events by date
+	locale := m.Locale.orEnglish()
+
+	// Group events and journal entries by date
 	eventsByDate := m.groupEventsByDate()
+	journalsByDate := m.groupJournalsByDate()
 
-	if len(eventsByDate) == 0 {
+	if len(eventsByDate) == 0 && len(journalsByDate) == 0 {
 		fmt.Fprintln(w, "No events this month.")
 		return nil
 	}
@@ -138,6 +225,11 @@ func (m *MonthView) RenderWithEvents(w io.Writer) error {
 	for date := range eventsByDate {
 		dates = append(dates, date)
 	}
+	for date := range journalsByDate {
+		if _, ok := eventsByDate[date]; !ok {
+			dates = append(dates, date)
+		}
+	}
 
 	// Simple sort by date string (YYYY-MM-DD format sorts correctly)
 	for i := 0; i < len(dates); i++ {
@@ -151,11 +243,13 @@ func (m *MonthView) RenderWithEvents(w io.Writer) error {
 	for _, dateStr := range dates {
 		events := eventsByDate[dateStr]
 		date, _ := time.Parse("2006-01-02", dateStr)
-		fmt.Fprintf(w, "\n%s %s:\n", date.Weekday().String()[:3], dateStr)
+		fmt.Fprintf(w, "\n%s %s:\n", locale.Weekday(date.Weekday()), dateStr)
 
 		for _, event := range events {
-			startTime := event.Start.Format("15:04")
-			fmt.Fprintf(w, "  %s %s\n", startTime, event.Summary)
+			fmt.Fprintf(w, "  %s\n", Colorize(m.Colors[event.Calendar], FormatDayEntry(event, date)))
+		}
+		for _, journal := range journalsByDate[dateStr] {
+			fmt.Fprintf(w, "  %s\n", FormatJournalEntry(journal))
 		}
 	}
 
@@ -163,11 +257,80 @@ func (m *MonthView) RenderWithEvents(w io.Writer) error {
 	return nil
 }
 
+// groupEventsByDate lists each event under every day of the month it covers
 func (m *MonthView) groupEventsByDate() map[string][]domain.Event {
 	groups := make(map[string][]domain.Event)
 	for _, event := range m.Events {
-		key := dateKey(event.Start)
-		groups[key] = append(groups[key], event)
+		event = event.In(m.location())
+		for _, day := range event.Dates() {
+			if day.Year() != m.Year || day.Month() != m.Month {
+				continue
+			}
+			key := dateKey(day)
+			groups[key] = append(groups[key], event)
+		}
 	}
 	return groups
 }
+
+// groupJournalsByDate lists each journal entry of the month under its day
+func (m *MonthView) groupJournalsByDate() map[string][]domain.Journal {
+	groups := make(map[string][]domain.Journal)
+	for _, journal := range m.Journals {
+		day := journal.Date(m.location())
+		if day.Year() != m.Year || day.Month() != m.Month {
+			continue
+		}
+		key := dateKey(day)
+		groups[key] = append(groups[key], journal)
+	}
+	return groups
+}
+
+// FormatJournalEntry formats a journal entry as listed under its day, e.g.
+// "¶ Standup notes"
+func FormatJournalEntry(journal domain.Journal) string {
+	summary := journal.Summary
+	if summary == "" {
+		summary = firstLine(journal.Description)
+	}
+	return fmt.Sprintf("%s %s", JournalMarker, summary)
+}
+
+// firstLine returns text up to its first line break
+func firstLine(text string) string {
+	line, _, _ := strings.Cut(text, "\n")
+	return line
+}
+
+// FormatDayEntry formats an event as listed under one of the days it covers,
+// e.g. "14:00 Meeting" or "all-day Conference (2/3)"
+func FormatDayEntry(event domain.Event, day time.Time) string {
+	return fmt.Sprintf("%s %s%s", eventLabel(event, day), event.Summary, spanSuffix(event, day))
+}
+
+// eventLabel returns the start time of an event on its first day, or a
+// marker for all-day events and continuation days
+func eventLabel(event domain.Event, day time.Time) string {
+	switch {
+	case event.AllDay:
+		return "all-day"
+	case dateKey(event.Start) != dateKey(day):
+		return "..."
+	}
+	return event.Start.Format("15:04")
+}
+
+// spanSuffix numbers the days of a multi-day event, e.g. " (2/3)"
+func spanSuffix(event domain.Event, day time.Time) string {
+	dates := event.Dates()
+	if len(dates) < 2 {
+		return ""
+	}
+	for i, date := range dates {
+		if dateKey(date) == dateKey(day) {
+			return fmt.Sprintf(" (%d/%d)", i+1, len(dates))
+		}
+	}
+	return ""
+}
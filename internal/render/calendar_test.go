@@ -7,6 +7,9 @@ import (
 	"time"
 
 	"github.com/NaMinhyeok/calcli/internal/domain"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 )
 
 func TestMonthView_Render(t *testing.T) {
@@ -208,3 +211,197 @@ func TestMonthView_DifferentMonths(t *testing.T) {
 		})
 	}
 }
+
+func TestMonthView_BucketsInDisplayLocation(t *testing.T) {
+	seoul := time.FixedZone("KST", 9*3600)
+	date := time.Date(2025, time.September, 1, 0, 0, 0, 0, seoul)
+	events := []domain.Event{
+		{
+			UID:     "late-utc",
+			Summary: "Late UTC call",
+			Start:   time.Date(2025, time.September, 4, 23, 30, 0, 0, time.UTC),
+			End:     time.Date(2025, time.September, 5, 0, 30, 0, 0, time.UTC),
+		},
+	}
+
+	view := NewMonthView(date, events)
+
+	grouped := view.groupEventsByDate()
+	if len(grouped["2025-09-05"]) != 1 {
+		t.Errorf("Expected event on 2025-09-05 in KST, got %v", grouped)
+	}
+	if len(grouped["2025-09-04"]) != 0 {
+		t.Error("Event should not be placed on its UTC date")
+	}
+}
+
+func TestMonthView_MultiDayEvents(t *testing.T) {
+	date := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+	events := []domain.Event{
+		{
+			UID:     "conference",
+			Summary: "Conference",
+			Start:   time.Date(2025, time.September, 29, 0, 0, 0, 0, time.UTC),
+			End:     time.Date(2025, time.October, 2, 0, 0, 0, 0, time.UTC),
+			AllDay:  true,
+		},
+		{
+			UID:     "night",
+			Summary: "Night Shift",
+			Start:   time.Date(2025, time.September, 10, 22, 0, 0, 0, time.UTC),
+			End:     time.Date(2025, time.September, 11, 6, 0, 0, 0, time.UTC),
+		},
+	}
+
+	view := NewMonthView(date, events)
+
+	var buf bytes.Buffer
+	if err := view.RenderWithEvents(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{
+		"all-day Conference (1/3)",
+		"all-day Conference (2/3)",
+		"22:00 Night Shift (1/2)",
+		"... Night Shift (2/2)",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q in output:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "(3/3)") {
+		t.Error("Days outside the month should not be listed")
+	}
+
+	eventMap := view.buildEventMap()
+	if eventMap["2025-09-30"] != 1 || eventMap["2025-09-11"] != 1 {
+		t.Errorf("Expected markers on every covered day, got %v", eventMap)
+	}
+}
+
+func TestMonthView_Journals(t *testing.T) {
+	date := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+	events := []domain.Event{
+		{
+			UID:     "standup",
+			Summary: "Standup",
+			Start:   time.Date(2025, time.September, 5, 9, 0, 0, 0, time.UTC),
+			End:     time.Date(2025, time.September, 5, 9, 15, 0, 0, time.UTC),
+		},
+	}
+	journals := []domain.Journal{
+		{UID: "notes-1", Summary: "Standup notes", Start: time.Date(2025, time.September, 5, 0, 0, 0, 0, time.UTC), AllDay: true},
+		{UID: "notes-2", Description: "Quiet day\nNothing planned", Start: time.Date(2025, time.September, 8, 18, 0, 0, 0, time.UTC)},
+		{UID: "notes-3", Summary: "Last month", Start: time.Date(2025, time.August, 29, 0, 0, 0, 0, time.UTC), AllDay: true},
+	}
+
+	view := NewMonthView(date, events)
+	view.Journals = journals
+	view.Today = time.Date(2025, time.September, 20, 0, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	if err := view.RenderWithEvents(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{
+		JournalMarker + " 5•",
+		JournalMarker + " 8 ",
+		"Fri 2025-09-05:\n  09:00 Standup\n  " + JournalMarker + " Standup notes\n",
+		"Mon 2025-09-08:\n  " + JournalMarker + " Quiet day\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q in output:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "Last month") {
+		t.Error("Entries outside the month should not be listed")
+	}
+}
+
+func TestMonthView_Colors(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(profile)
+	lipgloss.SetColorProfile(termenv.ANSI256)
+
+	date := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+	events := []domain.Event{
+		{Summary: "Review", Calendar: "work", Start: time.Date(2025, time.September, 10, 14, 0, 0, 0, time.UTC)},
+		{Summary: "Dinner", Calendar: "home", Start: time.Date(2025, time.September, 12, 19, 0, 0, 0, time.UTC)},
+	}
+
+	view := NewMonthView(date, events)
+	view.Today = time.Date(2025, time.September, 15, 9, 0, 0, 0, time.UTC)
+	view.Location = time.UTC
+	view.Colors = map[string]string{"work": "red"}
+
+	var buf bytes.Buffer
+	if err := view.RenderWithEvents(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output := buf.String()
+
+	for _, want := range []string{
+		" 10\x1b[31m•\x1b[0m",           // marker in the calendar color
+		" 12•",                          // calendar without a color
+		" \x1b[7m15\x1b[0m*",            // today
+		"  \x1b[31m14:00 Review\x1b[0m", // entry in the calendar color
+		"  19:00 Dinner\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q:\n%q", want, output)
+		}
+	}
+}
+
+func TestMonthView_WeekStartAndWeekNumbers(t *testing.T) {
+	view := NewMonthView(time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC), nil)
+	view.Today = time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)
+	view.WeekStart = time.Monday
+	view.ShowWeekNumbers = true
+
+	var buf bytes.Buffer
+	if err := view.Render(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "\n  January 2027\n\n" +
+		"  Wk  Mo  Tu  We  Th  Fr  Sa  Su\n" +
+		strings.Repeat("-", 32) + "\n" +
+		"  53                  1   2   3 \n" +
+		"   1  4   5   6   7   8   9  10 \n" +
+		"   2 11  12  13  14  15  16  17 \n" +
+		"   3 18  19  20  21  22  23  24 \n" +
+		"   4 25  26  27  28  29  30  31 \n" +
+		"\n"
+	if buf.String() != expected {
+		t.Errorf("expected:\n%q\ngot:\n%q", expected, buf.String())
+	}
+}
+
+func TestMonthView_Locale(t *testing.T) {
+	korean, err := LookupLocale("ko")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	events := []domain.Event{{Summary: "Review", Start: time.Date(2025, time.September, 10, 14, 0, 0, 0, time.UTC)}}
+	view := NewMonthView(time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC), events)
+	view.Location = time.UTC
+	view.Locale = korean
+
+	var buf bytes.Buffer
+	if err := view.RenderWithEvents(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output := buf.String()
+
+	for _, want := range []string{"2025년 9월", "  일  월  화  수  목  금  토\n", "수 2025-09-10:"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q:\n%s", want, output)
+		}
+	}
+}
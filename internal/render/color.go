@@ -0,0 +1,71 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ResetSequence ends the color started by ColorSequence
+const ResetSequence = "\x1b[0m"
+
+// namedColors maps the color names accepted for calendars to ANSI colors
+var namedColors = map[string]string{
+	"black":   "0",
+	"red":     "1",
+	"green":   "2",
+	"yellow":  "3",
+	"blue":    "4",
+	"magenta": "5",
+	"cyan":    "6",
+	"white":   "7",
+	"gray":    "8",
+	"grey":    "8",
+}
+
+// Color converts the color of a calendar, a name such as "blue", an ANSI
+// 256-color number or a hex code such as "#ff8800", to a lipgloss color
+func Color(spec string) lipgloss.Color {
+	spec = strings.ToLower(strings.TrimSpace(spec))
+	if code, ok := namedColors[spec]; ok {
+		return lipgloss.Color(code)
+	}
+	return lipgloss.Color(spec)
+}
+
+// ColorSequence returns the escape sequence switching the terminal to the
+// color spec, or "" when spec is empty or the output has no colors
+func ColorSequence(spec string) string {
+	if spec == "" {
+		return ""
+	}
+	return styleSequence(lipgloss.NewStyle().Foreground(Color(spec)))
+}
+
+// Colorize renders text in the color spec. Text is returned unchanged when
+// spec is empty or the output has no colors, e.g. when NO_COLOR is set or
+// stdout is not a terminal.
+func Colorize(spec, text string) string {
+	return wrapSequence(ColorSequence(spec), text)
+}
+
+// HighlightToday renders text, such as the date of today, in reverse video,
+// or unchanged when the output has no colors
+func HighlightToday(text string) string {
+	return wrapSequence(styleSequence(lipgloss.NewStyle().Reverse(true)), text)
+}
+
+// styleSequence returns the escape sequence switching the terminal to
+// style, or "" when the output has no colors
+func styleSequence(style lipgloss.Style) string {
+	sequence, _, _ := strings.Cut(style.Render("x"), "x")
+	return sequence
+}
+
+// wrapSequence starts text with sequence and resets the terminal after it
+func wrapSequence(sequence, text string) string {
+	if sequence == "" || text == "" {
+		return text
+	}
+	return sequence + text + ResetSequence
+}
@@ -0,0 +1,133 @@
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestColorSequence(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(profile)
+	lipgloss.SetColorProfile(termenv.ANSI256)
+
+	tests := []struct {
+		spec     string
+		expected string
+	}{
+		{spec: "", expected: ""},
+		{spec: "blue", expected: "\x1b[34m"},
+		{spec: " Red ", expected: "\x1b[31m"},
+		{spec: "208", expected: "\x1b[38;5;208m"},
+		{spec: "#ff0000", expected: "\x1b[38;5;196m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			if got := ColorSequence(tt.spec); got != tt.expected {
+				t.Errorf("ColorSequence(%q) = %q, want %q", tt.spec, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestColorSequence_NoColors(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(profile)
+	lipgloss.SetColorProfile(termenv.Ascii)
+
+	if got := ColorSequence("blue"); got != "" {
+		t.Errorf("expected no sequence without colors, got %q", got)
+	}
+	if got := Colorize("blue", "Meeting"); got != "Meeting" {
+		t.Errorf("expected plain text without colors, got %q", got)
+	}
+	if got := HighlightToday("16"); got != "16" {
+		t.Errorf("expected plain text without colors, got %q", got)
+	}
+}
+
+func TestColorize(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(profile)
+	lipgloss.SetColorProfile(termenv.ANSI256)
+
+	if got := Colorize("red", "Meeting"); got != "\x1b[31mMeeting\x1b[0m" {
+		t.Errorf("unexpected colored text %q", got)
+	}
+	if got := Colorize("", "Meeting"); got != "Meeting" {
+		t.Errorf("expected plain text without a color, got %q", got)
+	}
+	if got := HighlightToday("16"); got != "\x1b[7m16\x1b[0m" {
+		t.Errorf("unexpected highlighted text %q", got)
+	}
+}
+
+func TestTimeGridViews_Colors(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(profile)
+	lipgloss.SetColorProfile(termenv.ANSI256)
+
+	at := func(day, hour int) time.Time {
+		return time.Date(2026, time.October, day, hour, 0, 0, 0, time.UTC)
+	}
+	events := []domain.Event{
+		{Summary: "Review", Calendar: "work", Start: at(14, 9), End: at(14, 10)},
+		{Summary: "Trip", Calendar: "work", Start: at(14, 0), End: at(16, 0), AllDay: true},
+		{Summary: "Dinner", Calendar: "home", Start: at(14, 12), End: at(14, 13)},
+	}
+	colors := map[string]string{"work": "red"}
+
+	week := &WeekView{Date: at(14, 0), Today: at(16, 0), Location: time.UTC, Width: 62, FirstHour: 9, LastHour: 13, Events: events, Colors: colors}
+	day := &DayView{Date: at(14, 0), Now: at(16, 0), Location: time.UTC, Width: 60, FirstHour: 9, LastHour: 13, Events: events, Colors: colors}
+	year := &YearView{Year: 2026, Today: at(16, 0), Location: time.UTC, Events: events, Colors: colors}
+
+	tests := []struct {
+		name   string
+		render func(*bytes.Buffer) error
+		want   []string
+		unwant []string
+	}{
+		{
+			name:   "week",
+			render: func(buf *bytes.Buffer) error { return week.Render(buf) },
+			want:   []string{"\x1b[31m▌Review", "\x1b[31mTrip", "│▌Dinner"},
+			unwant: []string{"\x1b[31m▌Dinner"},
+		},
+		{
+			name:   "day",
+			render: func(buf *bytes.Buffer) error { return day.Render(buf) },
+			want:   []string{"\x1b[31mall-day Trip", "\x1b[31m▌09:00-10:00 Review", "12:00 ▌12:00-13:00 Dinner"},
+		},
+		{
+			name:   "year",
+			render: func(buf *bytes.Buffer) error { return year.Render(buf) },
+			want:   []string{"14\x1b[31m▒\x1b[0m", "15\x1b[31m░\x1b[0m"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.render(&buf); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("expected output to contain %q:\n%q", want, buf.String())
+				}
+			}
+			for _, unwant := range tt.unwant {
+				if strings.Contains(buf.String(), unwant) {
+					t.Errorf("expected output not to contain %q:\n%q", unwant, buf.String())
+				}
+			}
+		})
+	}
+}
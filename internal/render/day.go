@@ -0,0 +1,152 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+// DayView renders a day as a timeline with a row per half hour, followed by
+// the free time left in the working hours
+type DayView struct {
+	Date     time.Time
+	Events   []domain.Event
+	Now      time.Time         // marked on the timeline when it falls on Date
+	Location *time.Location    // zone used to place events on the day
+	Width    int               // total width in cells, 80 when zero
+	Colors   map[string]string // colors of calendars by name
+	Locale   Locale            // names of days in the heading, English when zero
+
+	// Working hours, DefaultFirstHour to DefaultLastHour when both are
+	// zero. The timeline grows to show every event; free time is only
+	// looked for within them.
+	FirstHour int
+	LastHour  int
+}
+
+// NewDayView creates a day view of the day holding date
+func NewDayView(date time.Time, events []domain.Event) *DayView {
+	return &DayView{
+		Date:     date,
+		Events:   events,
+		Now:      time.Now(),
+		Location: date.Location(),
+	}
+}
+
+// Render writes the day to w
+func (v *DayView) Render(w io.Writer) error {
+	loc := v.location()
+	date := v.Date.In(loc)
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+	width := v.Width
+	if width == 0 {
+		width = 80
+	}
+	colWidth := max(width-timeGutterWidth, 10)
+
+	var covering, spanning, timed []domain.Event
+	for _, event := range v.Events {
+		event = event.In(loc)
+		if !coversDay(event, day) {
+			continue
+		}
+		covering = append(covering, event)
+		if spansDays(event) {
+			spanning = append(spanning, event)
+		} else {
+			timed = append(timed, event)
+		}
+	}
+	domain.SortEvents(spanning)
+
+	fmt.Fprintln(w, DayHeading(day, v.Now.In(loc), v.Locale))
+	for _, event := range spanning {
+		fmt.Fprintf(w, "%s%s\n", strings.Repeat(" ", timeGutterWidth), Colorize(v.Colors[event.Calendar], FormatAgendaEntry(event, day)))
+	}
+	fmt.Fprintf(w, "%s%s\n", strings.Repeat(" ", timeGutterWidth), strings.Repeat("─", colWidth))
+
+	workStart, workEnd := gridHours(v.FirstHour, v.LastHour)
+	first, last := gridHours(workStart, workEnd, timed)
+	gridStart := atHour(day, first)
+	rows := (last - first) * slotsPerHour
+	lines := renderTimeColumn(timed, gridStart, rows, colWidth, v.Colors, dayBlockLine)
+
+	// The current time is marked above the first row starting after it
+	nowRow := -1
+	if dateKey(v.Now.In(loc)) == dateKey(day) {
+		slot := time.Hour / slotsPerHour
+		offset := wallClock(v.Now.In(loc)).Sub(wallClock(gridStart))
+		if offset >= 0 {
+			nowRow = int((offset + slot - 1) / slot)
+		}
+	}
+	for row := 0; row <= rows; row++ {
+		if row == nowRow {
+			fmt.Fprintf(w, "%s%s now\n", fitCell(v.Now.In(loc).Format("15:04"), timeGutterWidth), strings.Repeat("─", colWidth-4))
+		}
+		if row < rows {
+			fmt.Fprintln(w, strings.TrimRight(fitCell(hourLabel(first, row), timeGutterWidth)+lines[row], " "))
+		}
+	}
+
+	// Free time within the working hours, which events running from or
+	// into other days also take
+	free := domain.FreePeriods(covering, atHour(day, workStart), atHour(day, workEnd))
+	fmt.Fprintln(w)
+	if len(free) == 0 {
+		fmt.Fprintln(w, "Free: none")
+		return nil
+	}
+	fmt.Fprintln(w, "Free:")
+	for _, period := range free {
+		fmt.Fprintf(w, "  %s-%s (%s)\n", period.Start.Format("15:04"), formatClock(period.End, day), FormatDuration(period.Duration()))
+	}
+	return nil
+}
+
+func (v *DayView) location() *time.Location {
+	if v.Location != nil {
+		return v.Location
+	}
+	return v.Date.Location()
+}
+
+// dayBlockLine returns the text of row of a block in a day: its time range,
+// summary and length on the first row, the location on the second and the
+// block edge below
+func dayBlockLine(b *block, row int) string {
+	event := b.event
+	switch {
+	case row == b.start:
+		return fmt.Sprintf("%s%s-%s %s (%s)", blockEdge, event.Start.Format("15:04"), formatClock(event.EndTime(), event.Start), event.Summary, FormatDuration(event.Duration()))
+	case row == b.start+1 && event.Location != "":
+		return blockEdge + "@ " + event.Location
+	}
+	return blockEdge
+}
+
+// formatClock renders t as "15:04", or "24:00" when it is the midnight
+// ending day
+func formatClock(t, day time.Time) string {
+	if dateKey(t) != dateKey(day) && t.Hour() == 0 && t.Minute() == 0 {
+		return "24:00"
+	}
+	return t.Format("15:04")
+}
+
+// FormatDuration renders d without zero minutes and seconds, e.g. "1h" or
+// "1h30m" rather than "1h0m0s"
+func FormatDuration(d time.Duration) string {
+	s := d.String()
+	if d%time.Minute == 0 {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if d%time.Hour == 0 {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
@@ -0,0 +1,156 @@
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+func TestDayView_Render(t *testing.T) {
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, time.October, day, hour, minute, 0, 0, time.UTC)
+	}
+	view := &DayView{
+		Date:      at(16, 0, 0),
+		Now:       at(16, 10, 40),
+		Location:  time.UTC,
+		Width:     40,
+		FirstHour: 9,
+		LastHour:  12,
+		Events: []domain.Event{
+			{Summary: "Standup", Start: at(16, 9, 0), End: at(16, 9, 15)},
+			{Summary: "Review", Start: at(16, 10, 0), End: at(16, 11, 30), Location: "Room A"},
+			{Summary: "Conference", Start: at(16, 0, 0), End: at(18, 0, 0), AllDay: true},
+			{Summary: "Dinner", Start: at(16, 12, 0), End: at(16, 13, 0)},
+			{Summary: "Tomorrow", Start: at(17, 9, 0), End: at(17, 10, 0)},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := view.Render(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "Today, Fri 2026-10-16\n" +
+		"      all-day Conference (1/2)\n" +
+		"      ──────────────────────────────────\n" +
+		"09:00 ▌09:00-09:15 Standup (15m)\n" +
+		"\n" +
+		"10:00 ▌10:00-11:30 Review (1h30m)\n" +
+		"      ▌@ Room A\n" +
+		"10:40 ────────────────────────────── now\n" +
+		"11:00 ▌\n" +
+		"\n" +
+		"12:00 ▌12:00-13:00 Dinner (1h)\n" +
+		"      ▌\n" +
+		"\n" +
+		"Free:\n" +
+		"  09:15-10:00 (45m)\n" +
+		"  11:30-12:00 (30m)\n"
+	if buf.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+func TestDayView_RenderBusyDay(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2026, time.October, 16, hour, minute, 0, 0, time.UTC)
+	}
+	view := &DayView{
+		Date:      at(0, 0),
+		Now:       at(20, 0),
+		Location:  time.UTC,
+		FirstHour: 9,
+		LastHour:  10,
+		Events:    []domain.Event{{Summary: "Workshop", Start: at(8, 0), End: at(11, 0)}},
+	}
+
+	var buf bytes.Buffer
+	if err := view.Render(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.HasSuffix(buf.Bytes(), []byte("\nFree: none\n")) {
+		t.Errorf("expected no free time, got:\n%s", buf.String())
+	}
+	if bytes.Contains(buf.Bytes(), []byte(" now")) {
+		t.Errorf("expected no current time line after the timeline, got:\n%s", buf.String())
+	}
+}
+
+func TestDayView_RenderDaylightSavingChange(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	// Clocks go back an hour early on 2025-10-26
+	at := func(hour int) time.Time {
+		return time.Date(2025, time.October, 26, hour, 0, 0, 0, berlin)
+	}
+	view := &DayView{
+		Date:      at(0),
+		Now:       at(20),
+		Location:  berlin,
+		Width:     40,
+		FirstHour: 9,
+		LastHour:  12,
+		Events:    []domain.Event{{Summary: "DST day", Start: at(9), End: at(10)}},
+	}
+
+	var buf bytes.Buffer
+	if err := view.Render(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output := buf.String()
+	for _, want := range []string{"09:00 ▌09:00-10:00 DST day (1h)\n", "Free:\n  10:00-12:00 (2h)\n"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestDayView_RenderEventFromPreviousDay(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2026, time.October, day, hour, 0, 0, 0, time.UTC)
+	}
+	view := &DayView{
+		Date:      at(16, 0),
+		Now:       at(16, 20),
+		Location:  time.UTC,
+		FirstHour: 9,
+		LastHour:  12,
+		Events: []domain.Event{
+			{Summary: "Night shift", Start: at(15, 22), End: at(16, 11)},
+			{Summary: "Holiday", Start: at(16, 0), End: at(17, 0), AllDay: true},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := view.Render(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasSuffix(buf.String(), "\nFree:\n  11:00-12:00 (1h)\n") {
+		t.Errorf("expected free time after the night shift only, got:\n%s", buf.String())
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{15 * time.Minute, "15m"},
+		{time.Hour, "1h"},
+		{90 * time.Minute, "1h30m"},
+		{90 * time.Second, "1m30s"},
+		{24 * time.Hour, "24h"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatDuration(tt.d); got != tt.want {
+			t.Errorf("FormatDuration(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
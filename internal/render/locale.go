@@ -0,0 +1,157 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// Locale names months and weekdays in a language
+type Locale struct {
+	Months       [12]string
+	Weekdays     [7]string // short names starting on Sunday, e.g. "Mon"
+	GridWeekdays [7]string // names fitting two cells, e.g. "Mo"
+	Week         string    // heading of the week number column
+	MonthYear    string    // format of the month name and the year
+
+	// Names of the days around today in day headings
+	Today     string
+	Tomorrow  string
+	Yesterday string
+}
+
+// English is the default locale
+var English = Locale{
+	Months:       [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	Weekdays:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	GridWeekdays: [7]string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"},
+	Week:         "Wk",
+	MonthYear:    "%[1]s %[2]d",
+	Today:        "Today",
+	Tomorrow:     "Tomorrow",
+	Yesterday:    "Yesterday",
+}
+
+// locales maps language codes to their locales
+var locales = map[string]Locale{
+	"en": English,
+	"de": {
+		Months:       [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		Weekdays:     [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+		GridWeekdays: [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+		Week:         "KW",
+		MonthYear:    "%[1]s %[2]d",
+		Today:        "Heute",
+		Tomorrow:     "Morgen",
+		Yesterday:    "Gestern",
+	},
+	"es": {
+		Months:       [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		Weekdays:     [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		GridWeekdays: [7]string{"do", "lu", "ma", "mi", "ju", "vi", "sá"},
+		Week:         "Sem",
+		MonthYear:    "%[1]s de %[2]d",
+		Today:        "Hoy",
+		Tomorrow:     "Mañana",
+		Yesterday:    "Ayer",
+	},
+	"fr": {
+		Months:       [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		Weekdays:     [7]string{"dim", "lun", "mar", "mer", "jeu", "ven", "sam"},
+		GridWeekdays: [7]string{"di", "lu", "ma", "me", "je", "ve", "sa"},
+		Week:         "Sem",
+		MonthYear:    "%[1]s %[2]d",
+		Today:        "Aujourd'hui",
+		Tomorrow:     "Demain",
+		Yesterday:    "Hier",
+	},
+	"ja": {
+		Months:       [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		Weekdays:     [7]string{"日", "月", "火", "水", "木", "金", "土"},
+		GridWeekdays: [7]string{"日", "月", "火", "水", "木", "金", "土"},
+		Week:         "週",
+		MonthYear:    "%[2]d年 %[1]s",
+		Today:        "今日",
+		Tomorrow:     "明日",
+		Yesterday:    "昨日",
+	},
+	"ko": {
+		Months:       [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		Weekdays:     [7]string{"일", "월", "화", "수", "목", "금", "토"},
+		GridWeekdays: [7]string{"일", "월", "화", "수", "목", "금", "토"},
+		Week:         "주",
+		MonthYear:    "%[2]d년 %[1]s",
+		Today:        "오늘",
+		Tomorrow:     "내일",
+		Yesterday:    "어제",
+	},
+}
+
+// LookupLocale returns the locale of a language code such as "de" or a
+// locale name such as "de_DE.UTF-8"; English when name is empty
+func LookupLocale(name string) (Locale, error) {
+	if name == "" {
+		return English, nil
+	}
+	language := strings.ToLower(name)
+	if i := strings.IndexAny(language, "_-."); i >= 0 {
+		language = language[:i]
+	}
+	locale, ok := locales[language]
+	if !ok {
+		return Locale{}, fmt.Errorf("unknown locale '%s'. Available: %s", name, strings.Join(localeNames(), ", "))
+	}
+	return locale, nil
+}
+
+// localeNames returns the available language codes, sorted
+func localeNames() []string {
+	names := make([]string, 0, len(locales))
+	for name := range locales {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Month returns the name of month
+func (l Locale) Month(month time.Month) string {
+	return l.Months[month-1]
+}
+
+// Weekday returns the short name of day
+func (l Locale) Weekday(day time.Weekday) string {
+	return l.Weekdays[day]
+}
+
+// FormatMonthYear names a month of a year, e.g. "September 2025" or
+// "2025년 9월"
+func (l Locale) FormatMonthYear(month time.Month, year int) string {
+	return fmt.Sprintf(l.MonthYear, l.Month(month), year)
+}
+
+// orderedGridWeekdays returns the grid names of the weekdays in the order
+// of weeks starting on weekStart
+func (l Locale) orderedGridWeekdays(weekStart time.Weekday) []string {
+	days := make([]string, 7)
+	for i := range days {
+		days[i] = l.GridWeekdays[(int(weekStart)+i)%7]
+	}
+	return days
+}
+
+// orEnglish returns l, or English when l is the zero locale
+func (l Locale) orEnglish() Locale {
+	if l.MonthYear == "" {
+		return English
+	}
+	return l
+}
+
+// padLeft right-aligns text in width cells
+func padLeft(text string, width int) string {
+	return runewidth.FillLeft(text, width)
+}
@@ -0,0 +1,72 @@
+package render
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLookupLocale(t *testing.T) {
+	tests := []struct {
+		name    string
+		month   string
+		wantErr bool
+	}{
+		{name: "", month: "September"},
+		{name: "en", month: "September"},
+		{name: "de", month: "September"},
+		{name: "de_DE.UTF-8", month: "September"},
+		{name: "fr-FR", month: "septembre"},
+		{name: "KO", month: "9월"},
+		{name: "xx", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locale, err := LookupLocale(tt.name)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error for %q", tt.name)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := locale.Month(time.September); got != tt.month {
+				t.Errorf("expected %q, got %q", tt.month, got)
+			}
+		})
+	}
+}
+
+func TestLocale_FormatMonthYear(t *testing.T) {
+	korean, _ := LookupLocale("ko")
+	if got := korean.FormatMonthYear(time.March, 2026); got != "2026년 3월" {
+		t.Errorf("unexpected Korean month %q", got)
+	}
+	if got := English.FormatMonthYear(time.March, 2026); got != "March 2026" {
+		t.Errorf("unexpected English month %q", got)
+	}
+}
+
+func TestIsoWeek(t *testing.T) {
+	tests := []struct {
+		year      int
+		month     time.Month
+		weekStart time.Weekday
+		row       int
+		want      int
+	}{
+		{year: 2027, month: time.January, weekStart: time.Monday, row: 0, want: 53},
+		{year: 2027, month: time.January, weekStart: time.Monday, row: 1, want: 1},
+		{year: 2026, month: time.March, weekStart: time.Sunday, row: 0, want: 10},
+		{year: 2026, month: time.March, weekStart: time.Monday, row: 0, want: 9},
+		{year: 2026, month: time.December, weekStart: time.Monday, row: 4, want: 53},
+	}
+
+	for _, tt := range tests {
+		if got := isoWeek(tt.year, tt.month, tt.weekStart, tt.row); got != tt.want {
+			t.Errorf("isoWeek(%d, %v, %v, %d) = %d, want %d", tt.year, tt.month, tt.weekStart, tt.row, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+// frequencyUnits names the period of each RRULE frequency
+var frequencyUnits = map[string]string{
+	"SECONDLY": "second",
+	"MINUTELY": "minute",
+	"HOURLY":   "hour",
+	"DAILY":    "day",
+	"WEEKLY":   "week",
+	"MONTHLY":  "month",
+	"YEARLY":   "year",
+}
+
+// DescribeRecurrence renders a recurrence rule for people, e.g. "every 2
+// weeks on Tue, 10 times" or "monthly on the last Fri". Rule parts other
+// than BYDAY, BYMONTHDAY, BYMONTH, COUNT and UNTIL are not described.
+func DescribeRecurrence(rec *domain.Recurrence) string {
+	if rec == nil {
+		return ""
+	}
+
+	unit, ok := frequencyUnits[rec.Frequency]
+	if !ok {
+		unit = strings.ToLower(rec.Frequency)
+	}
+
+	var b strings.Builder
+	switch {
+	case rec.Interval > 1:
+		fmt.Fprintf(&b, "every %d %ss", rec.Interval, unit)
+	case rec.Frequency == "DAILY":
+		b.WriteString("daily")
+	case unit == "second" || unit == "minute":
+		b.WriteString("every " + unit)
+	default:
+		b.WriteString(unit + "ly")
+	}
+
+	if len(rec.ByDay) > 0 {
+		days := make([]string, len(rec.ByDay))
+		for i, day := range rec.ByDay {
+			days[i] = describeWeekdayNum(day)
+		}
+		b.WriteString(" on " + strings.Join(days, ", "))
+	}
+
+	if len(rec.ByMonthDay) > 0 {
+		days := make([]string, len(rec.ByMonthDay))
+		for i, day := range rec.ByMonthDay {
+			days[i] = describePosition(day) + " day"
+		}
+		b.WriteString(" on the " + strings.Join(days, ", "))
+	}
+
+	if len(rec.ByMonth) > 0 {
+		months := make([]string, len(rec.ByMonth))
+		for i, month := range rec.ByMonth {
+			if month >= 1 && month <= 12 {
+				months[i] = time.Month(month).String()[:3]
+			} else {
+				months[i] = fmt.Sprint(month)
+			}
+		}
+		b.WriteString(" in " + strings.Join(months, ", "))
+	}
+
+	switch {
+	case rec.Count != nil && *rec.Count == 1:
+		b.WriteString(", once")
+	case rec.Count != nil:
+		fmt.Fprintf(&b, ", %d times", *rec.Count)
+	case rec.Until != nil:
+		b.WriteString(", until " + rec.Until.Format("2006-01-02"))
+	}
+	return b.String()
+}
+
+// describeWeekdayNum renders a BYDAY entry, e.g. "Tue" or "the 2nd Tue"
+func describeWeekdayNum(day domain.WeekdayNum) string {
+	weekday := day.Weekday.String()[:3]
+	if day.N == 0 {
+		return weekday
+	}
+	return "the " + describePosition(day.N) + " " + weekday
+}
+
+// describePosition renders an ordinal counted from the start when positive
+// and from the end when negative, e.g. "2nd", "last" or "2nd to last"
+func describePosition(n int) string {
+	switch {
+	case n == -1:
+		return "last"
+	case n < 0:
+		return ordinal(-n) + " to last"
+	}
+	return ordinal(n)
+}
+
+// ordinal renders n as "1st", "2nd", "3rd", "4th", ...
+func ordinal(n int) string {
+	suffix := "th"
+	if n%100 < 11 || n%100 > 13 {
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
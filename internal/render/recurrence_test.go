@@ -0,0 +1,39 @@
+package render
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+func TestDescribeRecurrence(t *testing.T) {
+	count := func(n int) *int { return &n }
+	until := time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		rec  *domain.Recurrence
+		want string
+	}{
+		{"none", nil, ""},
+		{"daily", &domain.Recurrence{Frequency: "DAILY"}, "daily"},
+		{"weekly with interval and count", &domain.Recurrence{Frequency: "WEEKLY", Interval: 2, Count: count(10), ByDay: []domain.WeekdayNum{{Weekday: time.Tuesday}}}, "every 2 weeks on Tue, 10 times"},
+		{"several weekdays until", &domain.Recurrence{Frequency: "WEEKLY", Until: &until, ByDay: []domain.WeekdayNum{{Weekday: time.Monday}, {Weekday: time.Thursday}}}, "weekly on Mon, Thu, until 2025-12-31"},
+		{"nth weekday", &domain.Recurrence{Frequency: "MONTHLY", ByDay: []domain.WeekdayNum{{N: 2, Weekday: time.Tuesday}}}, "monthly on the 2nd Tue"},
+		{"last weekday", &domain.Recurrence{Frequency: "MONTHLY", ByDay: []domain.WeekdayNum{{N: -1, Weekday: time.Friday}}}, "monthly on the last Fri"},
+		{"month days", &domain.Recurrence{Frequency: "MONTHLY", ByMonthDay: []int{1, -2}}, "monthly on the 1st day, 2nd to last day"},
+		{"yearly in months", &domain.Recurrence{Frequency: "YEARLY", ByMonth: []int{1, 7}, ByMonthDay: []int{11}}, "yearly on the 11th day in Jan, Jul"},
+		{"once", &domain.Recurrence{Frequency: "YEARLY", Count: count(1)}, "yearly, once"},
+		{"every minute", &domain.Recurrence{Frequency: "MINUTELY"}, "every minute"},
+		{"hourly interval", &domain.Recurrence{Frequency: "HOURLY", Interval: 3}, "every 3 hours"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DescribeRecurrence(tt.rec); got != tt.want {
+				t.Errorf("DescribeRecurrence() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,341 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+const (
+	// timeGutterWidth is the width of the hour labels left of a time grid
+	timeGutterWidth = 6
+	// slotsPerHour is the number of rows per hour of a time grid
+	slotsPerHour = 2
+	// blockEdge marks the left edge of an event block in a time grid
+	blockEdge = "▌"
+)
+
+// Hours shown by time grids unless events fall outside them
+const (
+	DefaultFirstHour = 8
+	DefaultLastHour  = 18
+)
+
+// WeekView renders a week as a time grid with a column per day and a row
+// per half hour. All-day and multi-day events are listed in a strip above
+// the grid; overlapping events are placed side by side.
+type WeekView struct {
+	Date      time.Time // any day of the week
+	WeekStart time.Weekday
+	Events    []domain.Event
+	Today     time.Time
+	Location  *time.Location    // zone used to place events on days
+	Width     int               // total width in cells, 80 when zero
+	Locale    Locale            // names of weekdays, English when zero
+	Colors    map[string]string // colors of calendars by name
+
+	// Hours of the grid, DefaultFirstHour to DefaultLastHour when both are
+	// zero. The grid grows to show every event.
+	FirstHour int
+	LastHour  int
+}
+
+// NewWeekView creates a week view of the week holding date
+func NewWeekView(date time.Time, events []domain.Event) *WeekView {
+	return &WeekView{
+		Date:     date,
+		Events:   events,
+		Today:    time.Now(),
+		Location: date.Location(),
+	}
+}
+
+// Days returns midnight of the seven days of the week
+func (v *WeekView) Days() []time.Time {
+	loc := v.location()
+	date := v.Date.In(loc)
+	offset := (int(date.Weekday()) - int(v.WeekStart) + 7) % 7
+	first := time.Date(date.Year(), date.Month(), date.Day()-offset, 0, 0, 0, 0, loc)
+
+	days := make([]time.Time, 7)
+	for i := range days {
+		days[i] = first.AddDate(0, 0, i)
+	}
+	return days
+}
+
+// Render writes the week to w
+func (v *WeekView) Render(w io.Writer) error {
+	days := v.Days()
+	colWidth := v.columnWidth()
+
+	// Place events on the days they cover
+	strip := make([][]domain.Event, len(days))
+	timed := make([][]domain.Event, len(days))
+	for _, event := range v.Events {
+		event = event.In(v.location())
+		for i, day := range days {
+			if !coversDay(event, day) {
+				continue
+			}
+			if event.AllDay || event.IsMultiDay() {
+				strip[i] = append(strip[i], event)
+			} else {
+				timed[i] = append(timed[i], event)
+			}
+		}
+	}
+
+	// Header
+	locale := v.Locale.orEnglish()
+	labels := make([]string, len(days))
+	for i, day := range days {
+		label := locale.Weekday(day.Weekday()) + day.Format(" 02")
+		if dateKey(day) == dateKey(v.Today.In(v.location())) {
+			label = "*" + label
+		}
+		labels[i] = label
+	}
+	writeGridRow(w, "", labels, colWidth)
+
+	// All-day strip
+	stripRows := 0
+	for i := range strip {
+		domain.SortEvents(strip[i])
+		stripRows = max(stripRows, len(strip[i]))
+	}
+	for row := 0; row < stripRows; row++ {
+		cells := make([]string, len(days))
+		for i := range days {
+			if row < len(strip[i]) {
+				cells[i] = Colorize(v.Colors[strip[i][row].Calendar], strip[i][row].Summary)
+			}
+		}
+		writeGridRow(w, "", cells, colWidth)
+	}
+
+	// Time grid
+	separators := make([]string, len(days))
+	for i := range separators {
+		separators[i] = strings.Repeat("─", colWidth)
+	}
+	fmt.Fprintf(w, "%s%s\n", strings.Repeat(" ", timeGutterWidth), strings.Join(separators, "┼"))
+
+	first, last := gridHours(v.FirstHour, v.LastHour, timed...)
+	rows := (last - first) * slotsPerHour
+	columns := make([][]string, len(days))
+	for i, day := range days {
+		columns[i] = renderTimeColumn(timed[i], atHour(day, first), rows, colWidth, v.Colors, weekBlockLine)
+	}
+	for row := 0; row < rows; row++ {
+		cells := make([]string, len(days))
+		for i := range days {
+			cells[i] = columns[i][row]
+		}
+		writeGridRow(w, hourLabel(first, row), cells, colWidth)
+	}
+	return nil
+}
+
+func (v *WeekView) location() *time.Location {
+	if v.Location != nil {
+		return v.Location
+	}
+	return v.Date.Location()
+}
+
+// columnWidth shares the width left of the hour labels among the days,
+// keeping a separator between them
+func (v *WeekView) columnWidth() int {
+	width := v.Width
+	if width == 0 {
+		width = 80
+	}
+	return max((width-timeGutterWidth-6)/7, 3)
+}
+
+// coversDay reports whether event takes place on day
+func coversDay(event domain.Event, day time.Time) bool {
+	for _, date := range event.Dates() {
+		if dateKey(date) == dateKey(day) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeGridRow writes a row of cells fitted to width, after the hour label
+func writeGridRow(w io.Writer, label string, cells []string, width int) {
+	fitted := make([]string, len(cells))
+	for i, cell := range cells {
+		fitted[i] = fitCell(cell, width)
+	}
+	fmt.Fprintf(w, "%s%s\n", fitCell(label, timeGutterWidth), strings.TrimRight(strings.Join(fitted, "│"), " "))
+}
+
+// fitCell truncates or pads text, which may hold color sequences, to width
+// cells
+func fitCell(text string, width int) string {
+	text = ansi.Truncate(text, width, "")
+	return text + strings.Repeat(" ", max(width-ansi.StringWidth(text), 0))
+}
+
+// hourLabel labels the first row of every hour of a grid starting at first
+func hourLabel(first, row int) string {
+	if row%slotsPerHour != 0 {
+		return ""
+	}
+	return fmt.Sprintf("%02d:00", first+row/slotsPerHour)
+}
+
+// atHour returns hour o'clock on day, counted on the clock rather than from
+// midnight so days with a daylight saving change keep their hours
+func atHour(day time.Time, hour int) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, 0, 0, 0, day.Location())
+}
+
+// wallClock returns the clock reading of t as a UTC time, so that
+// differences count hours on the clock even across daylight saving changes
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// gridHours returns the hours a time grid spans: first to last, defaults
+// when both are zero, extended to hold every timed event
+func gridHours(first, last int, days ...[]domain.Event) (int, int) {
+	if first == 0 && last == 0 {
+		first, last = DefaultFirstHour, DefaultLastHour
+	}
+	for _, events := range days {
+		for _, event := range events {
+			first = min(first, event.Start.Hour())
+			end := event.EndTime()
+			endHour := 24
+			if dateKey(end) == dateKey(event.Start) {
+				endHour = end.Hour()
+				if end.Minute() > 0 || end.Second() > 0 {
+					endHour++
+				}
+			}
+			last = max(last, endHour)
+		}
+	}
+	return first, max(last, first+1)
+}
+
+// block is an event placed in a time grid column
+type block struct {
+	event      domain.Event
+	start, end int // rows, end exclusive
+	lane       int
+	lanes      int // lanes of the group of overlapping events
+}
+
+// layoutBlocks assigns rows to events starting at gridStart, by the clock
+// times they cover, and places overlapping ones in lanes side by side
+func layoutBlocks(events []domain.Event, gridStart time.Time, rows int) []*block {
+	slot := time.Hour / slotsPerHour
+	origin := wallClock(gridStart)
+	var blocks []*block
+	for _, event := range events {
+		start := int(wallClock(event.Start).Sub(origin) / slot)
+		end := int((wallClock(event.EndTime()).Sub(origin) + slot - 1) / slot)
+		start = min(max(start, 0), rows-1)
+		end = min(max(end, start+1), rows)
+		blocks = append(blocks, &block{event: event, start: start, end: end})
+	}
+	sort.SliceStable(blocks, func(i, j int) bool {
+		if blocks[i].start != blocks[j].start {
+			return blocks[i].start < blocks[j].start
+		}
+		return blocks[i].end > blocks[j].end
+	})
+
+	// Blocks overlapping each other, directly or through others, form a
+	// group sharing the column
+	var group []*block
+	var laneEnds []int
+	groupEnd := 0
+	closeGroup := func() {
+		for _, b := range group {
+			b.lanes = len(laneEnds)
+		}
+		group, laneEnds = nil, nil
+	}
+	for _, b := range blocks {
+		if len(group) > 0 && b.start >= groupEnd {
+			closeGroup()
+		}
+		b.lane = len(laneEnds)
+		for lane, end := range laneEnds {
+			if end <= b.start {
+				b.lane = lane
+				break
+			}
+		}
+		if b.lane == len(laneEnds) {
+			laneEnds = append(laneEnds, b.end)
+		} else {
+			laneEnds[b.lane] = b.end
+		}
+		group = append(group, b)
+		groupEnd = max(groupEnd, b.end)
+	}
+	closeGroup()
+	return blocks
+}
+
+// renderTimeColumn renders the rows of one day of a time grid starting at
+// gridStart, each width cells wide, with line giving the text of each row
+// of an event block, drawn in the color of its calendar
+func renderTimeColumn(events []domain.Event, gridStart time.Time, rows, width int, colors map[string]string, line func(b *block, row int) string) []string {
+	blocks := layoutBlocks(events, gridStart, rows)
+
+	lines := make([]string, rows)
+	for row := range lines {
+		var b strings.Builder
+		x := 0
+		for _, blk := range blocksInRow(blocks, row) {
+			from := width * blk.lane / blk.lanes
+			to := width * (blk.lane + 1) / blk.lanes
+			if from < x || to <= from {
+				continue
+			}
+			b.WriteString(strings.Repeat(" ", from-x))
+			b.WriteString(Colorize(colors[blk.event.Calendar], fitCell(line(blk, row), to-from)))
+			x = to
+		}
+		lines[row] = b.String()
+	}
+	return lines
+}
+
+// blocksInRow returns the blocks covering row, ordered by lane
+func blocksInRow(blocks []*block, row int) []*block {
+	var covering []*block
+	for _, b := range blocks {
+		if b.start <= row && row < b.end {
+			covering = append(covering, b)
+		}
+	}
+	sort.Slice(covering, func(i, j int) bool { return covering[i].lane < covering[j].lane })
+	return covering
+}
+
+// weekBlockLine returns the text of row of a block in a week: the summary
+// on its first row, the start time on the second and the block edge below
+func weekBlockLine(b *block, row int) string {
+	switch row - b.start {
+	case 0:
+		return blockEdge + b.event.Summary
+	case 1:
+		return blockEdge + b.event.Start.Format("15:04")
+	}
+	return blockEdge
+}
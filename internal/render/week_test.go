@@ -0,0 +1,142 @@
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+func TestWeekView_Render(t *testing.T) {
+	day := func(d, hour, minute int) time.Time {
+		return time.Date(2026, time.October, d, hour, minute, 0, 0, time.UTC)
+	}
+	view := &WeekView{
+		Date:      day(14, 0, 0),
+		Today:     day(16, 0, 0),
+		Location:  time.UTC,
+		Width:     62,
+		FirstHour: 9,
+		LastHour:  11,
+		Events: []domain.Event{
+			{Summary: "Standup", Start: day(12, 9, 0), End: day(12, 9, 15)},
+			{Summary: "Planning", Start: day(13, 9, 0), End: day(13, 10, 30)},
+			{Summary: "Review", Start: day(13, 9, 30), End: day(13, 11, 0)},
+			{Summary: "Trip", Start: day(14, 0, 0), End: day(16, 0, 0), AllDay: true},
+			{Summary: "Late call", Start: day(16, 11, 0), End: day(16, 12, 0)},
+			{Summary: "Next week", Start: day(19, 9, 0), End: day(19, 10, 0)},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := view.Render(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "      Sun 11 │Mon 12 │Tue 13 │Wed 14 │Thu 15 │*Fri 16│Sat 17\n" +
+		"             │       │       │Trip   │Trip   │       │\n" +
+		"      ───────┼───────┼───────┼───────┼───────┼───────┼───────\n" +
+		"09:00        │▌Standu│▌Pl    │       │       │       │\n" +
+		"             │       │▌09▌Rev│       │       │       │\n" +
+		"10:00        │       │▌  ▌09:│       │       │       │\n" +
+		"             │       │   ▌   │       │       │       │\n" +
+		"11:00        │       │       │       │       │▌Late c│\n" +
+		"             │       │       │       │       │▌11:00 │\n"
+	if buf.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+func TestWeekView_RenderDaylightSavingChange(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	// Clocks go back an hour early on Sunday 2025-10-26
+	view := &WeekView{
+		Date:      time.Date(2025, time.October, 26, 0, 0, 0, 0, berlin),
+		Location:  berlin,
+		Width:     62,
+		FirstHour: 9,
+		LastHour:  11,
+		Events: []domain.Event{
+			{Summary: "DST day", Start: time.Date(2025, 10, 26, 9, 0, 0, 0, berlin), End: time.Date(2025, 10, 26, 10, 0, 0, 0, berlin)},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := view.Render(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(buf.String(), "\n")
+	for _, line := range lines {
+		if strings.HasPrefix(line, "09:00") {
+			if !strings.Contains(line, "▌DST da") {
+				t.Errorf("expected the event in the 09:00 row, got %q\n%s", line, buf.String())
+			}
+			return
+		}
+	}
+	t.Errorf("expected a 09:00 row:\n%s", buf.String())
+}
+
+func TestWeekView_Days(t *testing.T) {
+	date := time.Date(2026, time.October, 14, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		weekStart time.Weekday
+		first     string
+	}{
+		{name: "weeks starting on Sunday", weekStart: time.Sunday, first: "2026-10-11"},
+		{name: "weeks starting on Monday", weekStart: time.Monday, first: "2026-10-12"},
+		{name: "weeks starting on Thursday", weekStart: time.Thursday, first: "2026-10-08"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view := &WeekView{Date: date, WeekStart: tt.weekStart}
+			days := view.Days()
+			if len(days) != 7 {
+				t.Fatalf("expected 7 days, got %d", len(days))
+			}
+			if dateKey(days[0]) != tt.first {
+				t.Errorf("expected week to start on %s, got %s", tt.first, dateKey(days[0]))
+			}
+			if days[0].Hour() != 0 {
+				t.Errorf("expected days at midnight, got %v", days[0])
+			}
+		})
+	}
+}
+
+func TestLayoutBlocks(t *testing.T) {
+	gridStart := time.Date(2026, time.October, 16, 8, 0, 0, 0, time.UTC)
+	at := func(hour, minute int) time.Time {
+		return time.Date(2026, time.October, 16, hour, minute, 0, 0, time.UTC)
+	}
+	events := []domain.Event{
+		{Summary: "A", Start: at(9, 0), End: at(10, 0)},
+		{Summary: "B", Start: at(9, 30), End: at(11, 0)},
+		{Summary: "C", Start: at(10, 0), End: at(10, 30)},
+		{Summary: "D", Start: at(12, 0), End: at(12, 10)},
+		{Summary: "E", Start: at(7, 0), End: at(8, 30)},
+	}
+
+	expected := map[string]struct{ start, end, lane, lanes int }{
+		"A": {2, 4, 0, 2},
+		"B": {3, 6, 1, 2},
+		"C": {4, 5, 0, 2},
+		"D": {8, 9, 0, 1},
+		"E": {0, 1, 0, 1},
+	}
+	for _, b := range layoutBlocks(events, gridStart, 20) {
+		want := expected[b.event.Summary]
+		got := struct{ start, end, lane, lanes int }{b.start, b.end, b.lane, b.lanes}
+		if got != want {
+			t.Errorf("%s: expected %+v, got %+v", b.event.Summary, want, got)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// DensityShades mark days of a year view by their number of events: none,
+// one, two or three, four or more
+var DensityShades = []string{" ", "░", "▒", "▓"}
+
+const (
+	// yearMonthWidth is the width of a month grid in a year view
+	yearMonthWidth = 7 * 3
+	// yearWeekWidth is the width of the week number column of a month grid
+	yearWeekWidth = 3
+	// yearMonthGap separates the month grids of a row
+	yearMonthGap = "  "
+)
+
+// YearView renders the twelve months of a year as compact grids, shading
+// each day by how many events it has
+type YearView struct {
+	Year     int
+	Events   []domain.Event
+	Today    time.Time
+	Location *time.Location    // zone used to place events on days
+	Width    int               // total width in cells; four months a row fit 90
+	Colors   map[string]string // colors of calendars by name
+
+	WeekStart       time.Weekday
+	Locale          Locale // names of months and weekdays, English when zero
+	ShowWeekNumbers bool   // ISO week numbers right of the weeks
+}
+
+// NewYearView creates a year view of the year holding date
+func NewYearView(date time.Time, events []domain.Event) *YearView {
+	return &YearView{
+		Year:     date.Year(),
+		Events:   events,
+		Today:    time.Now(),
+		Location: date.Location(),
+	}
+}
+
+// Render writes the year to w
+func (y *YearView) Render(w io.Writer) error {
+	perRow := y.MonthsPerRow()
+	rowWidth := perRow*y.monthWidth() + (perRow-1)*len(yearMonthGap)
+
+	counts := make(map[string]int)
+	for _, event := range y.Events {
+		for _, day := range event.In(y.location()).Dates() {
+			counts[dateKey(day)]++
+		}
+	}
+
+	colors := dayColors(y.Events, y.Colors, y.location())
+
+	fmt.Fprintf(w, "\n%s\n", strings.TrimRight(center(fmt.Sprint(y.Year), rowWidth), " "))
+	for first := time.January; first <= time.December; first += time.Month(perRow) {
+		var months [][]string
+		for month := first; month < first+time.Month(perRow) && month <= time.December; month++ {
+			months = append(months, y.monthLines(month, counts, colors))
+		}
+
+		fmt.Fprintln(w)
+		for line := 0; ; line++ {
+			var cells []string
+			done := true
+			for _, lines := range months {
+				if line < len(lines) {
+					cells = append(cells, lines[line])
+					done = false
+				} else {
+					cells = append(cells, strings.Repeat(" ", y.monthWidth()))
+				}
+			}
+			if done {
+				break
+			}
+			fmt.Fprintln(w, strings.TrimRight(strings.Join(cells, yearMonthGap), " "))
+		}
+	}
+	fmt.Fprintln(w)
+	return nil
+}
+
+// MonthsPerRow returns how many months are shown side by side: four when
+// they fit the width, three otherwise
+func (y *YearView) MonthsPerRow() int {
+	if y.Width >= 4*y.monthWidth()+3*len(yearMonthGap) {
+		return 4
+	}
+	return 3
+}
+
+// monthWidth returns the width of a month grid, with its week numbers
+func (y *YearView) monthWidth() int {
+	if y.ShowWeekNumbers {
+		return yearMonthWidth + yearWeekWidth
+	}
+	return yearMonthWidth
+}
+
+// monthLines renders a month as lines monthWidth wide: its name, the
+// weekdays and a line per week. Shades take the color of the day's first
+// event.
+func (y *YearView) monthLines(month time.Month, counts map[string]int, colors map[string]string) []string {
+	locale := y.Locale.orEnglish()
+	lines := []string{center(locale.Month(month), y.monthWidth())}
+
+	var header strings.Builder
+	for _, day := range locale.orderedGridWeekdays(y.WeekStart) {
+		header.WriteString(fitCell(padLeft(day, 2), 3))
+	}
+	if y.ShowWeekNumbers {
+		header.WriteString(fitCell(padLeft(locale.Week, yearWeekWidth), yearWeekWidth))
+	}
+	lines = append(lines, header.String())
+
+	today := dateKey(y.Today.In(y.location()))
+	for row, week := range monthWeeks(y.Year, month, y.WeekStart) {
+		var b strings.Builder
+		for _, day := range week {
+			if day == 0 {
+				b.WriteString("   ")
+				continue
+			}
+			key := dateKey(time.Date(y.Year, month, day, 0, 0, 0, 0, time.UTC))
+			marker := Colorize(colors[key], densityShade(counts[key]))
+			if key == today {
+				marker = "*"
+			}
+			fmt.Fprintf(&b, "%2d%s", day, marker)
+		}
+		if y.ShowWeekNumbers {
+			fmt.Fprintf(&b, "%3d", isoWeek(y.Year, month, y.WeekStart, row))
+		}
+		lines = append(lines, b.String())
+	}
+	return lines
+}
+
+func (y *YearView) location() *time.Location {
+	if y.Location == nil {
+		return time.Local
+	}
+	return y.Location
+}
+
+// densityShade returns the shade of a day with count events
+func densityShade(count int) string {
+	switch {
+	case count >= 4:
+		return DensityShades[3]
+	case count >= 2:
+		return DensityShades[2]
+	}
+	return DensityShades[count]
+}
+
+// center pads text with spaces on both sides to width cells
+func center(text string, width int) string {
+	left := max((width-runewidth.StringWidth(text))/2, 0)
+	return fitCell(strings.Repeat(" ", left)+text, width)
+}
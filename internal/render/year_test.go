@@ -0,0 +1,132 @@
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+func TestYearView_Render(t *testing.T) {
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2027, month, day, hour, 0, 0, 0, time.UTC)
+	}
+	meeting := func(month time.Month, day, hour int) domain.Event {
+		return domain.Event{Summary: "Meeting", Start: at(month, day, hour), End: at(month, day, hour+1)}
+	}
+	events := []domain.Event{
+		meeting(time.January, 5, 9),
+		meeting(time.January, 6, 9),
+		meeting(time.January, 6, 11),
+		meeting(time.January, 7, 9),
+		meeting(time.January, 7, 10),
+		meeting(time.January, 7, 11),
+		meeting(time.January, 7, 12),
+		{Summary: "Trip", Start: at(time.July, 10, 0), End: at(time.July, 13, 0), AllDay: true},
+		meeting(time.January, 5, 14),
+	}
+
+	view := &YearView{Year: 2027, Events: events, Today: at(time.March, 3, 8), Location: time.UTC, Width: 80}
+
+	var buf bytes.Buffer
+	if err := view.Render(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output := buf.String()
+
+	for _, want := range []string{
+		"       January               February                 March\n",
+		"Su Mo Tu We Th Fr Sa   Su Mo Tu We Th Fr Sa   Su Mo Tu We Th Fr Sa\n",
+		" 3  4  5▒ 6▒ 7▓ 8  9 ",
+		"     1  2  3* 4  5  6\n",
+		" 4  5  6  7  8  9 10░",
+		"11░12░13 14",
+		"       October               November               December\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q:\n%s", want, output)
+		}
+	}
+}
+
+func TestYearView_MonthsPerRow(t *testing.T) {
+	tests := []struct {
+		width int
+		want  int
+	}{
+		{width: 0, want: 3},
+		{width: 80, want: 3},
+		{width: 89, want: 3},
+		{width: 90, want: 4},
+		{width: 200, want: 4},
+	}
+
+	for _, tt := range tests {
+		view := &YearView{Year: 2027, Width: tt.width}
+		if got := view.MonthsPerRow(); got != tt.want {
+			t.Errorf("width %d: expected %d months per row, got %d", tt.width, tt.want, got)
+		}
+
+		var buf bytes.Buffer
+		view.Render(&buf)
+		if got := strings.Count(strings.Split(buf.String(), "\n")[4], "Su"); got != tt.want {
+			t.Errorf("width %d: expected %d months side by side, got %d", tt.width, tt.want, got)
+		}
+	}
+}
+
+func TestMonthWeeks(t *testing.T) {
+	weeks := monthWeeks(2027, time.February, time.Sunday)
+	if len(weeks) != 5 {
+		t.Fatalf("expected 5 weeks, got %d", len(weeks))
+	}
+	if weeks[0] != [7]int{0, 1, 2, 3, 4, 5, 6} {
+		t.Errorf("unexpected first week %v", weeks[0])
+	}
+	if weeks[4] != [7]int{28, 0, 0, 0, 0, 0, 0} {
+		t.Errorf("unexpected last week %v", weeks[4])
+	}
+}
+
+func TestMonthWeeks_MondayFirst(t *testing.T) {
+	weeks := monthWeeks(2027, time.February, time.Monday)
+	if len(weeks) != 4 {
+		t.Fatalf("expected 4 weeks, got %d", len(weeks))
+	}
+	if weeks[0] != [7]int{1, 2, 3, 4, 5, 6, 7} {
+		t.Errorf("unexpected first week %v", weeks[0])
+	}
+}
+
+func TestYearView_RenderWeekNumbers(t *testing.T) {
+	view := &YearView{Year: 2027, Today: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), Location: time.UTC, WeekStart: time.Monday, ShowWeekNumbers: true}
+
+	var buf bytes.Buffer
+	if err := view.Render(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output := buf.String()
+
+	for _, want := range []string{
+		"Mo Tu We Th Fr Sa Su  Wk  Mo Tu We Th Fr Sa Su  Wk  Mo Tu We Th Fr Sa Su  Wk\n",
+		"             1  2  3  53   1  2  3  4  5  6  7   5",
+		" 4  5  6  7  8  9 10   1",
+		"27 28 29 30 31        52\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q:\n%s", want, output)
+		}
+	}
+
+	// The week numbers widen the months, so four fit only in wider terminals
+	view.Width = 101
+	if got := view.MonthsPerRow(); got != 3 {
+		t.Errorf("expected 3 months per row at width 101, got %d", got)
+	}
+	view.Width = 102
+	if got := view.MonthsPerRow(); got != 4 {
+		t.Errorf("expected 4 months per row at width 102, got %d", got)
+	}
+}
@@ -1,7 +1,10 @@
 package cache
 
 import (
+	"container/list"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/NaMinhyeok/calcli/internal/domain"
@@ -14,37 +17,98 @@ type CachedEvent struct {
 	ModTime time.Time
 }
 
-// EventCache provides thread-safe caching for calendar events
+// Objects are the events and journal entries parsed from one file
+type Objects struct {
+	Events   []domain.Event
+	Journals []domain.Journal
+}
+
+// CachedFile represents the objects parsed from one file together with the
+// modification time and size the file had when it was parsed
+type CachedFile struct {
+	Objects
+	ModTime time.Time
+	Size    int64
+}
+
+// Stats reports how often cached entries were reused or evicted
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Size      int
+}
+
+// entry is an element of the LRU list holding either an event or a file
+type entry struct {
+	key   string
+	event *CachedEvent
+	file  *CachedFile
+}
+
+// weight returns the number of events and journal entries the entry counts
+// towards maxSize
+func (e *entry) weight() int {
+	if e.file != nil {
+		return len(e.file.Events) + len(e.file.Journals)
+	}
+	return 1
+}
+
+// EventCache provides thread-safe caching for calendar events. Once it
+// holds more than maxSize events, the least recently used entries are
+// evicted, though not before running scans end.
 type EventCache struct {
-	cache   sync.Map           // key: "calendar/uid" -> *CachedEvent
-	group   singleflight.Group // prevents duplicate loads
-	maxSize int                // maximum cache size (0 = unlimited)
-	mu      sync.RWMutex       // protects size counter
-	size    int                // current cache size
-	enabled bool               // cache enable/disable flag
+	mu           sync.Mutex               // protects the maps, list and size
+	lru          *list.List               // entries, most recently used first
+	events       map[string]*list.Element // key: "calendar/uid"
+	files        map[string]*list.Element // key: "calendar/path"
+	group        singleflight.Group       // prevents duplicate loads
+	maxSize      int                      // maximum cache size in objects (0 = unlimited)
+	size         int                      // current cache size in objects
+	enabled      bool                     // cache enable/disable flag
+	scans        int                      // running scans, which defer eviction
+	resolveZones ZoneResolver             // rebuilds declared zones from the index
+	hits         atomic.Uint64            // loads answered from the cache
+	misses       atomic.Uint64            // loads that ran the loader
+	evictions    atomic.Uint64            // entries evicted to honour maxSize
+	changed      atomic.Bool              // files changed since the index was loaded or saved
 }
 
 // NewEventCache creates a new EventCache
 func NewEventCache(maxSize int, enabled bool) *EventCache {
 	return &EventCache{
+		lru:     list.New(),
+		events:  make(map[string]*list.Element),
+		files:   make(map[string]*list.Element),
 		maxSize: maxSize,
 		enabled: enabled,
 	}
 }
 
+// WithZoneResolver sets how LoadIndex rebuilds the zones declared by the
+// VTIMEZONE sources of events. Without one they become fixed offsets.
+func (c *EventCache) WithZoneResolver(resolve ZoneResolver) *EventCache {
+	c.resolveZones = resolve
+	return c
+}
+
 // Get retrieves an event from the cache
 func (c *EventCache) Get(calendar, uid string) (*CachedEvent, bool) {
 	if !c.enabled {
 		return nil, false
 	}
 
-	key := makeKey(calendar, uid)
-	val, ok := c.cache.Load(key)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.events[makeKey(calendar, uid)]
 	if !ok {
 		return nil, false
 	}
 
-	return val.(*CachedEvent), true
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*entry).event, true
 }
 
 // Set stores an event in the cache with its modification time
@@ -53,25 +117,13 @@ func (c *EventCache) Set(calendar, uid string, event domain.Event, modTime time.
 		return
 	}
 
-	key := makeKey(calendar, uid)
-	cached := &CachedEvent{
-		Event:   event,
-		ModTime: modTime,
-	}
-
-	// Check if this is a new entry
-	_, loaded := c.cache.LoadOrStore(key, cached)
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-	if !loaded {
-		// New entry - increment size
-		c.mu.Lock()
-		c.size++
-		// If cache is full and maxSize > 0, we should evict (future enhancement)
-		c.mu.Unlock()
-	} else {
-		// Update existing entry
-		c.cache.Store(key, cached)
-	}
+	c.store(c.events, &entry{
+		key:   makeKey(calendar, uid),
+		event: &CachedEvent{Event: event, ModTime: modTime},
+	})
 }
 
 // Delete removes an event from the cache
@@ -80,32 +132,30 @@ func (c *EventCache) Delete(calendar, uid string) {
 		return
 	}
 
-	key := makeKey(calendar, uid)
-	_, existed := c.cache.LoadAndDelete(key)
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-	if existed {
-		c.mu.Lock()
-		c.size--
-		c.mu.Unlock()
+	if elem, ok := c.events[makeKey(calendar, uid)]; ok {
+		c.remove(c.events, elem)
 	}
 }
 
 // Clear removes all entries from the cache
 func (c *EventCache) Clear() {
-	c.cache.Range(func(key, value interface{}) bool {
-		c.cache.Delete(key)
-		return true
-	})
-
 	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lru.Init()
+	c.events = make(map[string]*list.Element)
+	c.files = make(map[string]*list.Element)
 	c.size = 0
-	c.mu.Unlock()
+	c.changed.Store(true)
 }
 
 // Size returns the current number of cached events
 func (c *EventCache) Size() int {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.size
 }
 
@@ -132,10 +182,11 @@ func (c *EventCache) LoadOrStore(calendar, uid string, fileModTime time.Time, lo
 	}
 
 	// Check if cache is valid
-	if c.IsValid(calendar, uid, fileModTime) {
-		cached, _ := c.Get(calendar, uid)
+	if cached, ok := c.Get(calendar, uid); ok && !fileModTime.After(cached.ModTime) {
+		c.hits.Add(1)
 		return cached.Event, nil
 	}
+	c.misses.Add(1)
 
 	// Use singleflight to prevent duplicate loads
 	key := makeKey(calendar, uid)
@@ -157,6 +208,180 @@ func (c *EventCache) LoadOrStore(calendar, uid string, fileModTime time.Time, lo
 	return val.(domain.Event), nil
 }
 
+// LoadFile returns the objects of the file at path in calendar. The loader
+// only runs when the file is not cached or its modification time or size
+// changed since it was parsed. Loader errors are not cached.
+func (c *EventCache) LoadFile(calendar, path string, fileModTime time.Time, fileSize int64, loader func() (Objects, error)) (Objects, error) {
+	if !c.enabled {
+		return loader()
+	}
+
+	key := makeKey(calendar, path)
+	if cached, ok := c.getFile(key); ok && cached.ModTime.Equal(fileModTime) && cached.Size == fileSize {
+		c.hits.Add(1)
+		return cached.Objects, nil
+	}
+	c.misses.Add(1)
+
+	// Use singleflight to prevent duplicate loads
+	val, err, _ := c.group.Do("file:"+key, func() (interface{}, error) {
+		objects, err := loader()
+		if err != nil {
+			return nil, err
+		}
+
+		c.storeFile(key, &CachedFile{Objects: objects, ModTime: fileModTime, Size: fileSize})
+		return objects, nil
+	})
+
+	if err != nil {
+		return Objects{}, err
+	}
+
+	return val.(Objects), nil
+}
+
+// DeleteFile removes the cached objects of the file at path
+func (c *EventCache) DeleteFile(calendar, path string) {
+	if !c.enabled {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.files[makeKey(calendar, path)]; ok {
+		c.remove(c.files, elem)
+	}
+}
+
+// PruneFiles removes the cached files of calendar whose path is not in keep,
+// such as files deleted since they were cached
+func (c *EventCache) PruneFiles(calendar string, keep map[string]bool) {
+	if !c.enabled {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prefix := makeKey(calendar, "")
+	for key, elem := range c.files {
+		if strings.HasPrefix(key, prefix) && !keep[strings.TrimPrefix(key, prefix)] {
+			c.remove(c.files, elem)
+		}
+	}
+}
+
+// BeginScan defers eviction until the matching EndScan. A scan reads every
+// file in turn, so evicting the least recently used file while it runs
+// would drop each file just before the next scan reads it again.
+func (c *EventCache) BeginScan() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.scans++
+}
+
+// EndScan ends a scan begun by BeginScan. Once no scan runs, the least
+// recently used entries beyond maxSize are evicted, keeping the files read
+// last for the next scan.
+func (c *EventCache) EndScan() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.scans--
+	c.evict()
+}
+
+// Stats returns the hit, miss and eviction counters and the current size
+func (c *EventCache) Stats() Stats {
+	return Stats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Size:      c.Size(),
+	}
+}
+
+// getFile returns the cached file under key, marking it recently used
+func (c *EventCache) getFile(key string) (*CachedFile, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.files[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*entry).file, true
+}
+
+// storeFile caches file under key, replacing any previous entry
+func (c *EventCache) storeFile(key string, file *CachedFile) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.store(c.files, &entry{key: key, file: file})
+}
+
+// snapshotFiles returns the cached files by key
+func (c *EventCache) snapshotFiles() map[string]*CachedFile {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	files := make(map[string]*CachedFile, len(c.files))
+	for key, elem := range c.files {
+		files[key] = elem.Value.(*entry).file
+	}
+	return files
+}
+
+// store adds e to index as the most recently used entry, replacing an entry
+// with the same key, and evicts entries beyond maxSize. c.mu must be held.
+func (c *EventCache) store(index map[string]*list.Element, e *entry) {
+	if elem, ok := index[e.key]; ok {
+		c.remove(index, elem)
+	}
+
+	index[e.key] = c.lru.PushFront(e)
+	c.size += e.weight()
+	if e.file != nil {
+		c.changed.Store(true)
+	}
+
+	c.evict()
+}
+
+// evict drops least recently used entries until the cache fits maxSize. The
+// most recent entry is kept even when it alone exceeds maxSize. Nothing is
+// evicted while a scan runs. c.mu must be held.
+func (c *EventCache) evict() {
+	if c.maxSize <= 0 || c.scans > 0 {
+		return
+	}
+
+	for c.size > c.maxSize && c.lru.Len() > 1 {
+		elem := c.lru.Back()
+		if elem.Value.(*entry).file != nil {
+			c.remove(c.files, elem)
+		} else {
+			c.remove(c.events, elem)
+		}
+		c.evictions.Add(1)
+	}
+}
+
+// remove drops elem from index and the LRU list. c.mu must be held.
+func (c *EventCache) remove(index map[string]*list.Element, elem *list.Element) {
+	e := elem.Value.(*entry)
+	c.lru.Remove(elem)
+	delete(index, e.key)
+	c.size -= e.weight()
+	if e.file != nil {
+		c.changed.Store(true)
+	}
+}
+
 // makeKey creates a cache key from calendar and uid
 func makeKey(calendar, uid string) string {
 	return calendar + "/" + uid
@@ -227,3 +227,18 @@ func BenchmarkEventCache_LargeCache(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkEventCache_SetWithEviction(b *testing.B) {
+	cache := NewEventCache(1000, true)
+	event := domain.Event{
+		UID:     "test-uid",
+		Summary: "Benchmark Event",
+	}
+	modTime := time.Now()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Every insert beyond the first 1000 evicts the oldest entry
+		cache.Set("home", fmt.Sprintf("uid-%d", i), event, modTime)
+	}
+}
@@ -299,3 +299,196 @@ func TestEventCache_SingleflightPreventsDoubleLoad(t *testing.T) {
 		t.Errorf("Expected loader called once due to singleflight, got %d", loadCount)
 	}
 }
+
+func TestEventCache_LoadFile(t *testing.T) {
+	cache := NewEventCache(100, true)
+
+	events := []domain.Event{{UID: "a"}, {UID: "b"}}
+	loadCount := 0
+	loader := func() (Objects, error) {
+		loadCount++
+		return Objects{Events: events}, nil
+	}
+
+	modTime := time.Now()
+	steps := []struct {
+		name      string
+		modTime   time.Time
+		size      int64
+		wantLoads int
+	}{
+		{"first load parses the file", modTime, 100, 1},
+		{"unchanged file is reused", modTime, 100, 1},
+		{"newer file is parsed again", modTime.Add(time.Second), 100, 2},
+		{"older file is parsed again", modTime, 100, 3},
+		{"resized file is parsed again", modTime, 120, 4},
+	}
+
+	for _, step := range steps {
+		result, err := cache.LoadFile("home", "multi.ics", step.modTime, step.size, loader)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", step.name, err)
+		}
+		if len(result.Events) != 2 {
+			t.Errorf("%s: expected 2 events, got %d", step.name, len(result.Events))
+		}
+		if loadCount != step.wantLoads {
+			t.Errorf("%s: expected %d loads, got %d", step.name, step.wantLoads, loadCount)
+		}
+	}
+
+	stats := cache.Stats()
+	if stats.Hits != 1 || stats.Misses != 4 {
+		t.Errorf("Expected 1 hit and 4 misses, got %+v", stats)
+	}
+	if stats.Size != 2 {
+		t.Errorf("Expected size 2 after replacing the file entry, got %d", stats.Size)
+	}
+
+	// Loader errors are not cached
+	_, err := cache.LoadFile("home", "broken.ics", modTime, 1, func() (Objects, error) {
+		return Objects{}, errors.New("read error")
+	})
+	if err == nil {
+		t.Error("Expected loader error")
+	}
+	if cache.Size() != 2 {
+		t.Errorf("Expected failed load not to be cached, size %d", cache.Size())
+	}
+}
+
+func TestEventCache_DeleteAndPruneFiles(t *testing.T) {
+	cache := NewEventCache(100, true)
+	modTime := time.Now()
+
+	store := func(calendar, path string, uids ...string) {
+		var events []domain.Event
+		for _, uid := range uids {
+			events = append(events, domain.Event{UID: uid})
+		}
+		cache.LoadFile(calendar, path, modTime, 1, func() (Objects, error) {
+			return Objects{Events: events}, nil
+		})
+	}
+	store("home", "a.ics", "a")
+	store("home", "b.ics", "b1", "b2")
+	store("home", "c.ics", "c")
+	store("work", "a.ics", "w")
+
+	cache.DeleteFile("home", "c.ics")
+	if cache.Size() != 4 {
+		t.Errorf("Expected size 4 after DeleteFile, got %d", cache.Size())
+	}
+
+	cache.PruneFiles("home", map[string]bool{"a.ics": true})
+	if cache.Size() != 2 {
+		t.Errorf("Expected size 2 after PruneFiles, got %d", cache.Size())
+	}
+
+	// The work calendar's entry is untouched
+	loaded := false
+	cache.LoadFile("work", "a.ics", modTime, 1, func() (Objects, error) {
+		loaded = true
+		return Objects{}, nil
+	})
+	if loaded {
+		t.Error("Expected work calendar to stay cached")
+	}
+}
+
+func TestEventCache_LRUEviction(t *testing.T) {
+	modTime := time.Now()
+	files := func(uids ...string) func() (Objects, error) {
+		return func() (Objects, error) {
+			var events []domain.Event
+			for _, uid := range uids {
+				events = append(events, domain.Event{UID: uid})
+			}
+			return Objects{Events: events}, nil
+		}
+	}
+
+	t.Run("evicts least recently used events", func(t *testing.T) {
+		cache := NewEventCache(3, true)
+		cache.Set("home", "a", domain.Event{UID: "a"}, modTime)
+		cache.Set("home", "b", domain.Event{UID: "b"}, modTime)
+		cache.Set("home", "c", domain.Event{UID: "c"}, modTime)
+
+		// Touch "a" so "b" becomes the least recently used
+		cache.Get("home", "a")
+		cache.Set("home", "d", domain.Event{UID: "d"}, modTime)
+
+		for uid, want := range map[string]bool{"a": true, "b": false, "c": true, "d": true} {
+			if _, ok := cache.Get("home", uid); ok != want {
+				t.Errorf("expected %s cached=%v, got %v", uid, want, ok)
+			}
+		}
+		if stats := cache.Stats(); stats.Size != 3 || stats.Evictions != 1 {
+			t.Errorf("expected size 3 and 1 eviction, got %+v", stats)
+		}
+	})
+
+	t.Run("files weigh their number of events", func(t *testing.T) {
+		cache := NewEventCache(4, true)
+		cache.LoadFile("home", "one.ics", modTime, 1, files("a", "b"))
+		cache.LoadFile("home", "two.ics", modTime, 1, files("c", "d"))
+		cache.LoadFile("home", "three.ics", modTime, 1, files("e"))
+
+		if stats := cache.Stats(); stats.Size != 3 || stats.Evictions != 1 {
+			t.Errorf("expected size 3 and 1 eviction, got %+v", stats)
+		}
+
+		reloaded := false
+		cache.LoadFile("home", "one.ics", modTime, 1, func() (Objects, error) {
+			reloaded = true
+			return Objects{}, nil
+		})
+		if !reloaded {
+			t.Error("expected the evicted file to be parsed again")
+		}
+	})
+
+	t.Run("keeps an entry larger than maxSize", func(t *testing.T) {
+		cache := NewEventCache(2, true)
+		cache.Set("home", "a", domain.Event{UID: "a"}, modTime)
+		objects, _ := cache.LoadFile("home", "big.ics", modTime, 1, files("b", "c", "d"))
+
+		if len(objects.Events) != 3 {
+			t.Errorf("expected 3 events, got %d", len(objects.Events))
+		}
+		if stats := cache.Stats(); stats.Size != 3 || stats.Evictions != 1 {
+			t.Errorf("expected only the big file to remain, got %+v", stats)
+		}
+	})
+
+	t.Run("defers eviction until the scan ends", func(t *testing.T) {
+		cache := NewEventCache(2, true)
+		scan := func() {
+			cache.BeginScan()
+			defer cache.EndScan()
+			for _, name := range []string{"a", "b", "c", "d"} {
+				cache.LoadFile("home", name+".ics", modTime, 1, files(name))
+			}
+		}
+
+		scan()
+		if stats := cache.Stats(); stats.Size != 2 || stats.Evictions != 2 {
+			t.Errorf("expected the last 2 files to remain, got %+v", stats)
+		}
+
+		scan()
+		if stats := cache.Stats(); stats.Hits != 2 || stats.Misses != 6 {
+			t.Errorf("expected the files read last to hit, got %+v", stats)
+		}
+	})
+
+	t.Run("zero maxSize is unlimited", func(t *testing.T) {
+		cache := NewEventCache(0, true)
+		for i := 0; i < 100; i++ {
+			cache.Set("home", string(rune('a'+i)), domain.Event{}, modTime)
+		}
+		if stats := cache.Stats(); stats.Size != 100 || stats.Evictions != 0 {
+			t.Errorf("expected 100 events and no evictions, got %+v", stats)
+		}
+	})
+}
@@ -0,0 +1,251 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+// ZoneResolver returns the zones declared by VTIMEZONE sources, such as
+// domain.Event.SourceZones, by TZID
+type ZoneResolver func(sources []string) map[string]*time.Location
+
+// indexFile is the on-disk form of the cached files. Layout describes
+// domain.Event and domain.Journal so indexes written by another version
+// are discarded.
+type indexFile struct {
+	Layout string                `json:"layout"`
+	Files  map[string]indexEntry `json:"files"`
+}
+
+// indexEntry holds the events and journal entries of one file. JSON keeps
+// only the UTC offset of a time, so Zones lists the zone of every time in
+// them in the order objectTimes visits them.
+type indexEntry struct {
+	Events   []domain.Event   `json:"events"`
+	Journals []domain.Journal `json:"journals"`
+	Zones    []string         `json:"zones"`
+	ModTime  time.Time        `json:"modTime"`
+	Size     int64            `json:"size"`
+}
+
+// LoadIndex fills the cache with the files stored in the index at path.
+// A missing index or one written for another event layout is ignored.
+func (c *EventCache) LoadIndex(path string) error {
+	if !c.enabled {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read index: %w", err)
+	}
+
+	var index indexFile
+	if err := json.Unmarshal(data, &index); err != nil {
+		return fmt.Errorf("failed to decode index %s: %w", path, err)
+	}
+	if index.Layout != objectLayout() {
+		return nil
+	}
+
+	for key, entry := range index.Files {
+		objects := Objects{Events: entry.Events, Journals: entry.Journals}
+		restoreZones(&objects, entry.Zones, c.resolveZones)
+		c.storeFile(key, &CachedFile{Objects: objects, ModTime: entry.ModTime, Size: entry.Size})
+	}
+	c.changed.Store(false)
+	return nil
+}
+
+// SaveIndex atomically writes the cached files to the index at path. It
+// does nothing when no file was parsed, replaced or dropped since LoadIndex.
+func (c *EventCache) SaveIndex(path string) error {
+	if !c.enabled || !c.changed.Load() {
+		return nil
+	}
+
+	index := indexFile{Layout: objectLayout(), Files: make(map[string]indexEntry)}
+	for key, file := range c.snapshotFiles() {
+		index.Files[key] = indexEntry{
+			Events:   file.Events,
+			Journals: file.Journals,
+			Zones:    collectZones(file.Objects),
+			ModTime:  file.ModTime,
+			Size:     file.Size,
+		}
+	}
+
+	data, err := json.Marshal(index)
+	if err != nil {
+		return fmt.Errorf("failed to encode index: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	tmpFile, err := os.CreateTemp(dir, "index_*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("failed to write index: %w", err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary file: %w", err)
+	}
+	if err := os.Rename(tmpFile.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace index %s: %w", path, err)
+	}
+
+	c.changed.Store(false)
+	return nil
+}
+
+// collectZones returns the zone name of every time in objects
+func collectZones(objects Objects) []string {
+	var zones []string
+	objectTimes(&objects, func(_ []string, t *time.Time) {
+		zones = append(zones, t.Location().String())
+	})
+	return zones
+}
+
+// restoreZones moves every time in objects back into the zone recorded by
+// collectZones. Zones that can't be loaded are rebuilt by resolve from the
+// VTIMEZONE sources of their event or journal entry, or become fixed zones
+// with the stored offset.
+func restoreZones(objects *Objects, zones []string, resolve ZoneResolver) {
+	locations := make(map[string]*time.Location)
+	declared := make(map[string]map[string]*time.Location)
+	i := 0
+	objectTimes(objects, func(sources []string, t *time.Time) {
+		if i >= len(zones) {
+			return
+		}
+		name := zones[i]
+		i++
+
+		loc, ok := locations[name]
+		if !ok {
+			loc, _ = time.LoadLocation(name)
+			locations[name] = loc
+		}
+		if loc == nil && resolve != nil && len(sources) > 0 {
+			key := strings.Join(sources, "")
+			if _, ok := declared[key]; !ok {
+				declared[key] = resolve(sources)
+			}
+			loc = declared[key][name]
+		}
+		if loc == nil {
+			_, offset := t.Zone()
+			loc = time.FixedZone(name, offset)
+		}
+		*t = t.In(loc)
+	})
+}
+
+// objectTimes calls fn for every time in objects, events first, with the
+// VTIMEZONE sources of the event or journal entry holding it
+func objectTimes(objects *Objects, fn func(sources []string, t *time.Time)) {
+	for i := range objects.Events {
+		sources := eventZoneSources(objects.Events[i])
+		eventTimes(&objects.Events[i], func(t *time.Time) {
+			fn(sources, t)
+		})
+	}
+	for i := range objects.Journals {
+		fn(objects.Journals[i].SourceZones, &objects.Journals[i].Start)
+	}
+}
+
+// eventZoneSources returns the VTIMEZONE sources of an event and its
+// overrides
+func eventZoneSources(event domain.Event) []string {
+	sources := event.SourceZones
+	for _, override := range event.Overrides {
+		sources = append(sources[:len(sources):len(sources)], override.SourceZones...)
+	}
+	return sources
+}
+
+// eventTimes calls fn for every time of event, its overrides included, in
+// field order
+func eventTimes(event *domain.Event, fn func(*time.Time)) {
+	fn(&event.Start)
+	fn(&event.End)
+	if event.Recurrence != nil && event.Recurrence.Until != nil {
+		fn(event.Recurrence.Until)
+	}
+	for i := range event.ExDates {
+		fn(&event.ExDates[i])
+	}
+	for i := range event.RDates {
+		fn(&event.RDates[i])
+	}
+	for i := range event.Alarms {
+		if event.Alarms[i].At != nil {
+			fn(event.Alarms[i].At)
+		}
+	}
+	if event.RecurrenceID != nil {
+		fn(event.RecurrenceID)
+	}
+	for i := range event.Overrides {
+		eventTimes(&event.Overrides[i], fn)
+	}
+	if event.SeriesRule != nil && event.SeriesRule.Until != nil {
+		fn(event.SeriesRule.Until)
+	}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// objectLayout describes the fields of domain.Event and domain.Journal,
+// nested types included
+func objectLayout() string {
+	var b strings.Builder
+	seen := make(map[reflect.Type]bool)
+	describeType(&b, reflect.TypeOf(domain.Event{}), seen)
+	describeType(&b, reflect.TypeOf(domain.Journal{}), seen)
+	return b.String()
+}
+
+func describeType(b *strings.Builder, t reflect.Type, seen map[reflect.Type]bool) {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice:
+		b.WriteString(t.Kind().String() + ":")
+		describeType(b, t.Elem(), seen)
+	case reflect.Struct:
+		b.WriteString(t.String())
+		if seen[t] || t == timeType {
+			return
+		}
+		seen[t] = true
+		b.WriteString("{")
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			b.WriteString(field.Name + " ")
+			describeType(b, field.Type, seen)
+			b.WriteString(";")
+		}
+		b.WriteString("}")
+	default:
+		b.WriteString(t.String())
+	}
+}
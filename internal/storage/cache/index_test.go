@@ -0,0 +1,240 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+func TestEventCache_SaveAndLoadIndex(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+	custom := time.FixedZone("Custom/Zone", 5*3600)
+
+	sunday := time.Sunday
+	count := 0
+	moved := time.Date(2025, 3, 31, 9, 0, 0, 0, berlin)
+	events := []domain.Event{
+		{
+			UID:     "series",
+			Summary: "Standup",
+			Start:   time.Date(2025, 3, 28, 9, 0, 0, 0, berlin),
+			End:     time.Date(2025, 3, 28, 9, 15, 0, 0, berlin),
+			Recurrence: &domain.Recurrence{
+				Frequency: "WEEKLY",
+				Interval:  1,
+				Count:     &count,
+				WeekStart: &sunday,
+			},
+			ExDates: []time.Time{time.Date(2025, 4, 4, 9, 0, 0, 0, berlin)},
+			Overrides: []domain.Event{
+				{
+					UID:          "series",
+					Start:        time.Date(2025, 3, 31, 11, 0, 0, 0, custom),
+					End:          time.Date(2025, 3, 31, 11, 15, 0, 0, custom),
+					RecurrenceID: &moved,
+				},
+			},
+		},
+		{
+			UID:      "floating",
+			Start:    time.Date(2025, 3, 3, 12, 0, 0, 0, time.Local),
+			Floating: true,
+		},
+		{
+			UID:   "utc",
+			Start: time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	journals := []domain.Journal{{UID: "notes", Start: time.Date(2025, 3, 3, 0, 0, 0, 0, berlin), AllDay: true}}
+
+	modTime := time.Date(2025, 3, 1, 8, 0, 0, 123, time.UTC)
+	indexPath := filepath.Join(t.TempDir(), "cache", "index.json")
+
+	saved := NewEventCache(0, true)
+	saved.LoadFile("home", "events.ics", modTime, 42, func() (Objects, error) {
+		return Objects{Events: events, Journals: journals}, nil
+	})
+	if err := saved.SaveIndex(indexPath); err != nil {
+		t.Fatalf("SaveIndex failed: %v", err)
+	}
+
+	loaded := NewEventCache(0, true)
+	if err := loaded.LoadIndex(indexPath); err != nil {
+		t.Fatalf("LoadIndex failed: %v", err)
+	}
+	if loaded.Size() != 4 {
+		t.Fatalf("Expected 3 events and a journal entry from the index, got %d", loaded.Size())
+	}
+
+	got, err := loaded.LoadFile("home", "events.ics", modTime, 42, func() (Objects, error) {
+		t.Error("Expected unchanged file to be served from the index")
+		return Objects{}, nil
+	})
+	if err != nil {
+		t.Fatalf("LoadFile failed: %v", err)
+	}
+
+	series := got.Events[0]
+	if series.Start.Location().String() != "Europe/Berlin" || !series.Start.Equal(events[0].Start) {
+		t.Errorf("Expected start in Europe/Berlin, got %v", series.Start)
+	}
+	if series.Recurrence.Count == nil || *series.Recurrence.Count != 0 {
+		t.Errorf("Expected COUNT pointer to survive, got %v", series.Recurrence.Count)
+	}
+	if series.Recurrence.WeekStart == nil || *series.Recurrence.WeekStart != time.Sunday {
+		t.Errorf("Expected WKST=SU to survive, got %v", series.Recurrence.WeekStart)
+	}
+	if len(series.ExDates) != 1 || series.ExDates[0].Location().String() != "Europe/Berlin" {
+		t.Errorf("Expected EXDATE in Europe/Berlin, got %v", series.ExDates)
+	}
+	override := series.Overrides[0]
+	if name, offset := override.Start.Zone(); name != "Custom/Zone" || offset != 5*3600 {
+		t.Errorf("Expected override in Custom/Zone, got %s %d", name, offset)
+	}
+	if override.RecurrenceID == nil || !override.RecurrenceID.Equal(moved) {
+		t.Errorf("Expected RECURRENCE-ID %v, got %v", moved, override.RecurrenceID)
+	}
+	if got.Events[1].Start.Location() != time.Local {
+		t.Errorf("Expected floating event in Local, got %v", got.Events[1].Start.Location())
+	}
+	if got.Events[2].Start.Location() != time.UTC {
+		t.Errorf("Expected UTC event in UTC, got %v", got.Events[2].Start.Location())
+	}
+	if len(got.Journals) != 1 || got.Journals[0].Start.Location().String() != "Europe/Berlin" || !got.Journals[0].Start.Equal(journals[0].Start) {
+		t.Errorf("Expected the journal entry in Europe/Berlin, got %v", got.Journals)
+	}
+}
+
+func TestEventCache_IndexRefresh(t *testing.T) {
+	indexPath := filepath.Join(t.TempDir(), "index.json")
+	modTime := time.Now()
+	loader := func() (Objects, error) {
+		return Objects{Events: []domain.Event{{UID: "a"}}}, nil
+	}
+
+	t.Run("missing index is empty", func(t *testing.T) {
+		c := NewEventCache(0, true)
+		if err := c.LoadIndex(indexPath); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if c.Size() != 0 {
+			t.Errorf("Expected empty cache, got %d", c.Size())
+		}
+	})
+
+	t.Run("unchanged cache is not rewritten", func(t *testing.T) {
+		c := NewEventCache(0, true)
+		c.LoadFile("home", "a.ics", modTime, 1, loader)
+		if err := c.SaveIndex(indexPath); err != nil {
+			t.Fatalf("SaveIndex failed: %v", err)
+		}
+
+		reloaded := NewEventCache(0, true)
+		if err := reloaded.LoadIndex(indexPath); err != nil {
+			t.Fatalf("LoadIndex failed: %v", err)
+		}
+		reloaded.LoadFile("home", "a.ics", modTime, 1, loader)
+
+		os.Remove(indexPath)
+		if err := reloaded.SaveIndex(indexPath); err != nil {
+			t.Fatalf("SaveIndex failed: %v", err)
+		}
+		if _, err := os.Stat(indexPath); !os.IsNotExist(err) {
+			t.Error("Expected no write when nothing changed")
+		}
+
+		reloaded.PruneFiles("home", nil)
+		if err := reloaded.SaveIndex(indexPath); err != nil {
+			t.Fatalf("SaveIndex failed: %v", err)
+		}
+		if _, err := os.Stat(indexPath); err != nil {
+			t.Errorf("Expected index to be rewritten after pruning: %v", err)
+		}
+	})
+
+	t.Run("index of another layout is ignored", func(t *testing.T) {
+		if err := os.WriteFile(indexPath, []byte(`{"layout":"old","files":{"home/a.ics":{"events":[{"UID":"a"}]}}}`), 0644); err != nil {
+			t.Fatal(err)
+		}
+		c := NewEventCache(0, true)
+		if err := c.LoadIndex(indexPath); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if c.Size() != 0 {
+			t.Errorf("Expected outdated index to be ignored, got %d events", c.Size())
+		}
+	})
+
+	t.Run("corrupt index is an error", func(t *testing.T) {
+		if err := os.WriteFile(indexPath, []byte("{"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := NewEventCache(0, true).LoadIndex(indexPath); err == nil {
+			t.Error("Expected error for corrupt index")
+		}
+	})
+}
+
+func TestEventCache_IndexRestoresDeclaredZones(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+	declared := time.FixedZone("W. Europe Standard Time", 3600)
+	events := []domain.Event{{
+		UID:         "weekly",
+		Start:       time.Date(2025, 7, 1, 10, 0, 0, 0, declared),
+		SourceZones: []string{"BEGIN:VTIMEZONE\r\nTZID:W. Europe Standard Time\r\nEND:VTIMEZONE\r\n"},
+	}}
+
+	modTime := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	indexPath := filepath.Join(t.TempDir(), "index.json")
+	saved := NewEventCache(0, true)
+	saved.LoadFile("work", "weekly.ics", modTime, 1, func() (Objects, error) {
+		return Objects{Events: events}, nil
+	})
+	if err := saved.SaveIndex(indexPath); err != nil {
+		t.Fatalf("SaveIndex failed: %v", err)
+	}
+
+	load := func(resolve ZoneResolver) domain.Event {
+		t.Helper()
+		loaded := NewEventCache(0, true).WithZoneResolver(resolve)
+		if err := loaded.LoadIndex(indexPath); err != nil {
+			t.Fatalf("LoadIndex failed: %v", err)
+		}
+		got, err := loaded.LoadFile("work", "weekly.ics", modTime, 1, func() (Objects, error) {
+			t.Error("Expected unchanged file to be served from the index")
+			return Objects{}, nil
+		})
+		if err != nil || len(got.Events) != 1 {
+			t.Fatalf("LoadFile failed: %v %v", got, err)
+		}
+		return got.Events[0]
+	}
+
+	var sources []string
+	got := load(func(s []string) map[string]*time.Location {
+		sources = s
+		return map[string]*time.Location{"W. Europe Standard Time": berlin}
+	})
+	if !reflect.DeepEqual(sources, events[0].SourceZones) {
+		t.Errorf("Expected the resolver to get the event's VTIMEZONE, got %q", sources)
+	}
+	if got.Start.Location() != berlin || !got.Start.Equal(events[0].Start) {
+		t.Errorf("Expected start in the resolved zone, got %v", got.Start)
+	}
+
+	got = load(nil)
+	if name, offset := got.Start.Zone(); name != "W. Europe Standard Time" || offset != 3600 {
+		t.Errorf("Expected a fixed zone without a resolver, got %s %d", name, offset)
+	}
+}
@@ -0,0 +1,138 @@
+package vdir
+
+import (
+	"fmt"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+// MultiReader merges the events of several calendars
+type MultiReader struct {
+	readers []*Reader
+}
+
+func NewMultiReader(readers ...*Reader) *MultiReader {
+	return &MultiReader{readers: readers}
+}
+
+func (m *MultiReader) ListEvents() ([]domain.Event, error) {
+	var allEvents []domain.Event
+	for _, reader := range m.readers {
+		events, err := reader.ListEvents()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read calendar %s: %w", reader.name, err)
+		}
+		allEvents = append(allEvents, events...)
+	}
+	return allEvents, nil
+}
+
+// ListTodos returns the todos of every calendar
+func (m *MultiReader) ListTodos() ([]domain.Todo, error) {
+	var allTodos []domain.Todo
+	for _, reader := range m.readers {
+		todos, err := reader.ListTodos()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read calendar %s: %w", reader.name, err)
+		}
+		allTodos = append(allTodos, todos...)
+	}
+	return allTodos, nil
+}
+
+// ListJournals returns the journal entries of every calendar
+func (m *MultiReader) ListJournals() ([]domain.Journal, error) {
+	var allJournals []domain.Journal
+	for _, reader := range m.readers {
+		journals, err := reader.ListJournals()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read calendar %s: %w", reader.name, err)
+		}
+		allJournals = append(allJournals, journals...)
+	}
+	return allJournals, nil
+}
+
+// WriterSet routes updates and deletions to the calendar holding an event
+// or todo
+type WriterSet struct {
+	writers []*Writer
+}
+
+func NewWriterSet(writers ...*Writer) *WriterSet {
+	return &WriterSet{writers: writers}
+}
+
+func (s *WriterSet) FindEventByUID(uid string) (domain.Event, error) {
+	writer, err := s.writerFor(uid, "event")
+	if err != nil {
+		return domain.Event{}, err
+	}
+	return writer.FindEventByUID(uid)
+}
+
+// UpdateEvent rewrites the event in the calendar that holds it
+func (s *WriterSet) UpdateEvent(event domain.Event) error {
+	writer, err := s.writerFor(event.UID, "event")
+	if err != nil {
+		return err
+	}
+	return writer.UpdateEvent(event)
+}
+
+func (s *WriterSet) DeleteEvent(uid string) error {
+	writer, err := s.writerFor(uid, "event")
+	if err != nil {
+		return err
+	}
+	return writer.DeleteEvent(uid)
+}
+
+// EventPath returns the path of the file holding the event in any calendar
+func (s *WriterSet) EventPath(uid string) (string, error) {
+	writer, err := s.writerFor(uid, "event")
+	if err != nil {
+		return "", err
+	}
+	return writer.EventPath(uid)
+}
+
+func (s *WriterSet) FindTodoByUID(uid string) (domain.Todo, error) {
+	writer, err := s.writerFor(uid, "todo")
+	if err != nil {
+		return domain.Todo{}, err
+	}
+	return writer.FindTodoByUID(uid)
+}
+
+// UpdateTodo rewrites the todo in the calendar that holds it
+func (s *WriterSet) UpdateTodo(todo domain.Todo) error {
+	writer, err := s.writerFor(todo.UID, "todo")
+	if err != nil {
+		return err
+	}
+	return writer.UpdateTodo(todo)
+}
+
+func (s *WriterSet) DeleteTodo(uid string) error {
+	writer, err := s.writerFor(uid, "todo")
+	if err != nil {
+		return err
+	}
+	return writer.DeleteTodo(uid)
+}
+
+// writerFor returns the writer of the first calendar containing the event
+// or todo (kind) with uid
+func (s *WriterSet) writerFor(uid, kind string) (*Writer, error) {
+	for _, writer := range s.writers {
+		_, _, err := writer.findFile(uid, kind)
+		if err == nil {
+			return writer, nil
+		}
+		if !isNotFound(err) {
+			return nil, err
+		}
+	}
+	return nil, &NotFoundError{Kind: kind, UID: uid}
+}
@@ -0,0 +1,168 @@
+package vdir
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NaMinhyeok/calcli/internal/domain"
+)
+
+func TestMultiReader_ListEvents(t *testing.T) {
+	base := t.TempDir()
+	homeDir := filepath.Join(base, "personal")
+	workDir := filepath.Join(base, "office")
+
+	for dir, uid := range map[string]string{homeDir: "home-1", workDir: "work-1"} {
+		event := domain.Event{
+			UID:     uid,
+			Summary: uid,
+			Start:   time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+			End:     time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC),
+		}
+		if err := NewWriter(dir).CreateEvent(event); err != nil {
+			t.Fatalf("failed to create event: %v", err)
+		}
+	}
+
+	reader := NewMultiReader(
+		NewReader(os.DirFS(homeDir), ".").WithName("home"),
+		NewReader(os.DirFS(workDir), ".").WithName("work"),
+	)
+
+	events, err := reader.ListEvents()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	calendars := make(map[string]string)
+	for _, event := range events {
+		calendars[event.UID] = event.Calendar
+	}
+	expected := map[string]string{"home-1": "home", "work-1": "work"}
+	if len(calendars) != len(expected) {
+		t.Fatalf("expected %d events, got %d", len(expected), len(calendars))
+	}
+	for uid, calendar := range expected {
+		if calendars[uid] != calendar {
+			t.Errorf("expected %s in calendar %q, got %q", uid, calendar, calendars[uid])
+		}
+	}
+}
+
+func TestWriterSet_RoutesToOwningCalendar(t *testing.T) {
+	homeDir := t.TempDir()
+	workDir := t.TempDir()
+
+	event := domain.Event{
+		UID:     "work-1",
+		Summary: "Review",
+		Start:   time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+		End:     time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC),
+	}
+	if err := NewWriter(workDir).CreateEvent(event); err != nil {
+		t.Fatalf("failed to create event: %v", err)
+	}
+
+	set := NewWriterSet(NewWriter(homeDir), NewWriter(workDir))
+
+	found, err := set.FindEventByUID("work-1")
+	if err != nil {
+		t.Fatalf("expected to find event, got %v", err)
+	}
+
+	if path, err := set.EventPath("work-1"); err != nil || path != filepath.Join(workDir, "work-1.ics") {
+		t.Errorf("expected the path of the event in the work calendar, got %q (%v)", path, err)
+	}
+
+	found.Summary = "Review (moved)"
+	if err := set.UpdateEvent(found); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(homeDir, "work-1.ics")); !os.IsNotExist(err) {
+		t.Error("expected update to stay out of the home calendar")
+	}
+	updated, err := NewWriter(workDir).FindEventByUID("work-1")
+	if err != nil || updated.Summary != "Review (moved)" {
+		t.Errorf("expected updated summary in work calendar, got %q (%v)", updated.Summary, err)
+	}
+
+	if err := set.DeleteEvent("work-1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(workDir, "work-1.ics")); !os.IsNotExist(err) {
+		t.Error("expected event file to be removed from the work calendar")
+	}
+
+	if err := set.DeleteEvent("missing"); err == nil {
+		t.Error("expected error for unknown UID")
+	}
+}
+
+func TestMultiReader_ListTodos(t *testing.T) {
+	homeDir := t.TempDir()
+	workDir := t.TempDir()
+
+	if err := NewWriter(homeDir).CreateTodo(domain.Todo{UID: "home-todo", Summary: "Groceries"}); err != nil {
+		t.Fatalf("failed to create todo: %v", err)
+	}
+	event := domain.Event{
+		UID:   "work-event",
+		Start: time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+		End:   time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC),
+	}
+	if err := NewWriter(workDir).CreateEvent(event); err != nil {
+		t.Fatalf("failed to create event: %v", err)
+	}
+
+	reader := NewMultiReader(
+		NewReader(os.DirFS(homeDir), ".").WithName("home"),
+		NewReader(os.DirFS(workDir), ".").WithName("work"),
+	)
+
+	todos, err := reader.ListTodos()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(todos) != 1 || todos[0].UID != "home-todo" || todos[0].Calendar != "home" {
+		t.Errorf("expected only the home todo, got %+v", todos)
+	}
+
+	writers := NewWriterSet(NewWriter(homeDir), NewWriter(workDir))
+	if _, err := writers.FindTodoByUID("work-event"); err == nil {
+		t.Error("expected an event not to be found as a todo")
+	}
+}
+
+func TestMultiReader_ListJournals(t *testing.T) {
+	homeDir := t.TempDir()
+	workDir := t.TempDir()
+
+	journal := domain.Journal{
+		UID:         "notes-1",
+		Summary:     "Standup notes",
+		Description: "Release moved to Friday.",
+		Start:       time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		AllDay:      true,
+	}
+	if err := NewWriter(workDir).CreateJournal(journal); err != nil {
+		t.Fatalf("failed to create journal entry: %v", err)
+	}
+	if err := NewWriter(homeDir).CreateTodo(domain.Todo{UID: "home-todo", Summary: "Groceries"}); err != nil {
+		t.Fatalf("failed to create todo: %v", err)
+	}
+
+	reader := NewMultiReader(
+		NewReader(os.DirFS(homeDir), ".").WithName("home"),
+		NewReader(os.DirFS(workDir), ".").WithName("work"),
+	)
+
+	journals, err := reader.ListJournals()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(journals) != 1 || journals[0].Calendar != "work" || journals[0].Description != journal.Description {
+		t.Errorf("expected only the work entry, got %+v", journals)
+	}
+}
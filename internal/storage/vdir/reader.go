@@ -13,6 +13,7 @@ import (
 type Reader struct {
 	fs    fs.FS
 	path  string
+	name  string
 	cache *cache.EventCache
 }
 
@@ -24,6 +25,13 @@ func NewReader(filesystem fs.FS, calendarPath string) *Reader {
 	}
 }
 
+// WithName sets the calendar name assigned to events. Without a name the
+// directory holding each file is used.
+func (r *Reader) WithName(name string) *Reader {
+	r.name = name
+	return r
+}
+
 // WithCache configures the reader to use caching
 func (r *Reader) WithCache(c *cache.EventCache) *Reader {
 	r.cache = c
@@ -32,6 +40,25 @@ func (r *Reader) WithCache(c *cache.EventCache) *Reader {
 
 func (r *Reader) ListEvents() ([]domain.Event, error) {
 	var allEvents []domain.Event
+	err := r.walkFiles(func(objects cache.Objects) {
+		allEvents = append(allEvents, objects.Events...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return allEvents, nil
+}
+
+// walkFiles passes the events and journal entries of every .ics file of the
+// calendar to visit, reusing the cached objects of unchanged files
+func (r *Reader) walkFiles(visit func(objects cache.Objects)) error {
+	seen := make(map[string]bool)
+
+	// Every file is read, so keep them all until the walk is done
+	if r.cache != nil {
+		r.cache.BeginScan()
+		defer r.cache.EndScan()
+	}
 
 	err := fs.WalkDir(r.fs, r.path, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
@@ -42,38 +69,144 @@ func (r *Reader) ListEvents() ([]domain.Event, error) {
 			return nil
 		}
 
-		calendarName := filepath.Base(filepath.Dir(path))
+		calendarName := r.name
+		if calendarName == "" {
+			calendarName = filepath.Base(filepath.Dir(path))
+		}
 
-		// Load events (with or without cache)
-		return r.loadEventsFromFile(path, calendarName, &allEvents)
+		// Load objects (with or without cache)
+		seen[path] = true
+		objects, err := r.loadFile(path, d, calendarName)
+		if err != nil {
+			return err
+		}
+		visit(objects)
+		return nil
 	})
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return allEvents, nil
+	// Forget files deleted since they were cached
+	if r.cache != nil {
+		r.cache.PruneFiles(r.cacheName(), seen)
+	}
+
+	return nil
+}
+
+// cacheName returns the namespace of the reader's files in the cache
+func (r *Reader) cacheName() string {
+	if r.name != "" {
+		return r.name
+	}
+	return r.path
+}
+
+// loadFile loads the objects of a file, reusing the cached objects while
+// the file's modification time and size are unchanged
+func (r *Reader) loadFile(path string, d fs.DirEntry, calendarName string) (cache.Objects, error) {
+	load := func() (cache.Objects, error) {
+		return r.parseFile(path, calendarName)
+	}
+
+	if r.cache == nil {
+		return load()
+	}
+
+	info, err := d.Info()
+	if err != nil {
+		// The file was removed while walking the directory
+		return cache.Objects{}, nil
+	}
+	return r.cache.LoadFile(r.cacheName(), path, info.ModTime(), info.Size(), load)
 }
 
-// loadEventsFromFile loads events from a file, using cache if available
-func (r *Reader) loadEventsFromFile(path string, calendarName string, allEvents *[]domain.Event) error {
+// parseFile parses the events and journal entries stored in the file at
+// path. Files that can't be parsed yield nothing so the remaining files are
+// still listed.
+func (r *Reader) parseFile(path string, calendarName string) (cache.Objects, error) {
 	file, err := r.fs.Open(path)
 	if err != nil {
-		return err
+		return cache.Objects{}, err
 	}
 	defer file.Close()
 
-	events, err := ical.ParseEvents(file)
+	objects, err := ical.ParseObjects(file)
 	if err != nil {
 		// Skip files that can't be parsed, but continue processing others
-		return nil
+		return cache.Objects{}, nil
 	}
 
-	// Set calendar name for all events
-	for i := range events {
-		events[i].Calendar = calendarName
+	// Set calendar name for all events and journal entries
+	for i := range objects.Events {
+		objects.Events[i].Calendar = calendarName
+	}
+	for i := range objects.Journals {
+		objects.Journals[i].Calendar = calendarName
 	}
 
-	*allEvents = append(*allEvents, events...)
-	return nil
+	return cache.Objects{Events: objects.Events, Journals: objects.Journals}, nil
+}
+
+// ListTodos returns the todos of the calendar. Todos are few and read on
+// demand, so they bypass the event cache.
+func (r *Reader) ListTodos() ([]domain.Todo, error) {
+	var allTodos []domain.Todo
+	err := r.walkObjects(func(objects ical.Objects, calendarName string) {
+		for _, todo := range objects.Todos {
+			todo.Calendar = calendarName
+			allTodos = append(allTodos, todo)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return allTodos, nil
+}
+
+// ListJournals returns the journal entries of the calendar. They are cached
+// together with the events of their file.
+func (r *Reader) ListJournals() ([]domain.Journal, error) {
+	var allJournals []domain.Journal
+	err := r.walkFiles(func(objects cache.Objects) {
+		allJournals = append(allJournals, objects.Journals...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return allJournals, nil
+}
+
+// walkObjects parses every .ics file of the calendar, skipping those that
+// can't be parsed like walkFiles, and passes its contents to visit
+func (r *Reader) walkObjects(visit func(objects ical.Objects, calendarName string)) error {
+	return fs.WalkDir(r.fs, r.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || !strings.HasSuffix(strings.ToLower(d.Name()), ".ics") {
+			return nil
+		}
+
+		file, err := r.fs.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		objects, err := ical.ParseObjects(file)
+		if err != nil {
+			return nil
+		}
+
+		calendarName := r.name
+		if calendarName == "" {
+			calendarName = filepath.Base(filepath.Dir(path))
+		}
+		visit(objects, calendarName)
+		return nil
+	})
 }
@@ -1,10 +1,16 @@
 package vdir
 
 import (
+	"path/filepath"
+	"reflect"
+	"sort"
 	"testing"
 	"testing/fstest"
+	"time"
 
 	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/ical"
+	"github.com/NaMinhyeok/calcli/internal/storage/cache"
 )
 
 func TestReader_ListEvents(t *testing.T) {
@@ -106,3 +112,176 @@ END:VCALENDAR`),
 		})
 	}
 }
+
+func TestReader_ListEventsWithCache(t *testing.T) {
+	ics := func(events ...string) []byte {
+		data := "BEGIN:VCALENDAR\nVERSION:2.0\nPRODID:-//Test//Test//EN\n"
+		for _, uid := range events {
+			data += "BEGIN:VEVENT\nUID:" + uid + "\nSUMMARY:" + uid + "\nDTSTART:20250828T100000Z\nDTEND:20250828T110000Z\nEND:VEVENT\n"
+		}
+		return []byte(data + "END:VCALENDAR\n")
+	}
+
+	modTime := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	testFS := fstest.MapFS{
+		"multi.ics":  &fstest.MapFile{Data: ics("m1", "m2"), ModTime: modTime},
+		"single.ics": &fstest.MapFile{Data: ics("s1"), ModTime: modTime},
+		"broken.ics": &fstest.MapFile{Data: []byte("not a calendar"), ModTime: modTime},
+	}
+
+	eventCache := cache.NewEventCache(0, true)
+	reader := NewReader(testFS, ".").WithName("home").WithCache(eventCache)
+
+	uids := func() []string {
+		t.Helper()
+		events, err := reader.ListEvents()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var uids []string
+		for _, event := range events {
+			uids = append(uids, event.UID)
+		}
+		sort.Strings(uids)
+		return uids
+	}
+
+	if got := uids(); !reflect.DeepEqual(got, []string{"m1", "m2", "s1"}) {
+		t.Errorf("first read: got %v", got)
+	}
+	if stats := eventCache.Stats(); stats.Hits != 0 || stats.Misses != 3 || stats.Size != 3 {
+		t.Errorf("first read: unexpected stats %+v", stats)
+	}
+
+	if got := uids(); !reflect.DeepEqual(got, []string{"m1", "m2", "s1"}) {
+		t.Errorf("cached read: got %v", got)
+	}
+	if stats := eventCache.Stats(); stats.Hits != 3 || stats.Misses != 3 {
+		t.Errorf("cached read: unexpected stats %+v", stats)
+	}
+
+	// A changed file is parsed again, a deleted one disappears
+	testFS["multi.ics"] = &fstest.MapFile{Data: ics("m1", "m3"), ModTime: modTime.Add(time.Minute)}
+	delete(testFS, "single.ics")
+
+	if got := uids(); !reflect.DeepEqual(got, []string{"m1", "m3"}) {
+		t.Errorf("after changes: got %v", got)
+	}
+	if stats := eventCache.Stats(); stats.Hits != 4 || stats.Misses != 4 || stats.Size != 2 {
+		t.Errorf("after changes: unexpected stats %+v", stats)
+	}
+}
+
+func TestReader_ListEventsLargerThanCache(t *testing.T) {
+	modTime := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	testFS := fstest.MapFS{}
+	for _, uid := range []string{"a", "b", "c", "d", "e"} {
+		data := "BEGIN:VCALENDAR\nVERSION:2.0\nPRODID:-//Test//Test//EN\n" +
+			"BEGIN:VEVENT\nUID:" + uid + "\nSUMMARY:" + uid + "\nDTSTART:20250828T100000Z\nDTEND:20250828T110000Z\nEND:VEVENT\n" +
+			"END:VCALENDAR\n"
+		testFS[uid+".ics"] = &fstest.MapFile{Data: []byte(data), ModTime: modTime}
+	}
+
+	eventCache := cache.NewEventCache(3, true)
+	reader := NewReader(testFS, ".").WithName("home").WithCache(eventCache)
+
+	for i := 0; i < 3; i++ {
+		events, err := reader.ListEvents()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(events) != 5 {
+			t.Fatalf("expected 5 events, got %d", len(events))
+		}
+	}
+
+	// Each read after the first reuses the 3 files the previous one kept
+	if stats := eventCache.Stats(); stats.Hits != 6 || stats.Misses != 9 || stats.Size != 3 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestReader_ListEventsFromIndexKeepsDeclaredZones(t *testing.T) {
+	data := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"BEGIN:VTIMEZONE\r\n" +
+		"TZID:W. Europe Standard Time\r\n" +
+		"BEGIN:STANDARD\r\n" +
+		"DTSTART:16010101T030000\r\n" +
+		"TZOFFSETFROM:+0200\r\n" +
+		"TZOFFSETTO:+0100\r\n" +
+		"RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=10\r\n" +
+		"END:STANDARD\r\n" +
+		"BEGIN:DAYLIGHT\r\n" +
+		"DTSTART:16010101T020000\r\n" +
+		"TZOFFSETFROM:+0100\r\n" +
+		"TZOFFSETTO:+0200\r\n" +
+		"RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=3\r\n" +
+		"END:DAYLIGHT\r\n" +
+		"END:VTIMEZONE\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:weekly\r\n" +
+		"DTSTART;TZID=W. Europe Standard Time:20250701T100000\r\n" +
+		"DTEND;TZID=W. Europe Standard Time:20250701T110000\r\n" +
+		"RRULE:FREQ=WEEKLY\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+	modTime := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	testFS := fstest.MapFS{"weekly.ics": &fstest.MapFile{Data: []byte(data), ModTime: modTime}}
+	indexPath := filepath.Join(t.TempDir(), "index.json")
+
+	saved := cache.NewEventCache(0, true)
+	if _, err := NewReader(testFS, ".").WithName("work").WithCache(saved).ListEvents(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := saved.SaveIndex(indexPath); err != nil {
+		t.Fatalf("SaveIndex failed: %v", err)
+	}
+
+	loaded := cache.NewEventCache(0, true).WithZoneResolver(ical.DeclaredZones)
+	if err := loaded.LoadIndex(indexPath); err != nil {
+		t.Fatalf("LoadIndex failed: %v", err)
+	}
+	events, err := NewReader(testFS, ".").WithName("work").WithCache(loaded).ListEvents()
+	if err != nil || len(events) != 1 {
+		t.Fatalf("unexpected result: %v %v", events, err)
+	}
+	if stats := loaded.Stats(); stats.Hits != 1 {
+		t.Errorf("expected the file to be served from the index, got %+v", stats)
+	}
+
+	// A winter occurrence keeps 10:00 local time, 09:00 UTC
+	winter := time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)
+	occurrences := domain.ExpandRecurrence(events[0], winter, winter.AddDate(0, 0, 1))
+	if len(occurrences) != 1 || occurrences[0].Start.UTC().Hour() != 9 {
+		t.Errorf("expected a 09:00 UTC occurrence, got %v", occurrences)
+	}
+}
+
+func TestReader_ListJournalsWithCache(t *testing.T) {
+	data := "BEGIN:VCALENDAR\nVERSION:2.0\nPRODID:-//Test//Test//EN\n" +
+		"BEGIN:VEVENT\nUID:standup\nSUMMARY:Standup\nDTSTART:20250901T090000Z\nDTEND:20250901T091500Z\nEND:VEVENT\n" +
+		"BEGIN:VJOURNAL\nUID:notes\nSUMMARY:Standup notes\nDTSTART;VALUE=DATE:20250901\nEND:VJOURNAL\n" +
+		"END:VCALENDAR\n"
+	modTime := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	testFS := fstest.MapFS{"day.ics": &fstest.MapFile{Data: []byte(data), ModTime: modTime}}
+
+	eventCache := cache.NewEventCache(0, true)
+	reader := NewReader(testFS, ".").WithName("home").WithCache(eventCache)
+
+	if _, err := reader.ListEvents(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	journals, err := reader.ListJournals()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(journals) != 1 || journals[0].UID != "notes" || journals[0].Calendar != "home" {
+		t.Errorf("unexpected journal entries %+v", journals)
+	}
+
+	// The entries come from the file parsed for the events
+	if stats := eventCache.Stats(); stats.Hits != 1 || stats.Misses != 1 || stats.Size != 2 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
@@ -1,6 +1,7 @@
 package vdir
 
 import (
+	"errors"
 	"fmt"
 	"io/fs"
 	"os"
@@ -9,24 +10,115 @@ import (
 
 	"github.com/NaMinhyeok/calcli/internal/domain"
 	"github.com/NaMinhyeok/calcli/internal/ical"
+	"github.com/NaMinhyeok/calcli/internal/storage/cache"
 )
 
 type Writer struct {
 	basePath string
+	name     string
+	readOnly bool
+	cache    *cache.EventCache
+}
+
+// ReadOnlyError is returned for writes to a calendar configured as read-only
+type ReadOnlyError struct {
+	Calendar string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("calendar '%s' is read-only", e.Calendar)
+}
+
+// NotFoundError is returned when no event or todo (Kind) has the UID
+type NotFoundError struct {
+	Kind string
+	UID  string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s with UID %s not found", e.Kind, e.UID)
+}
+
+// isNotFound reports whether err says the event or todo does not exist
+func isNotFound(err error) bool {
+	var notFound *NotFoundError
+	return errors.As(err, &notFound)
 }
 
 func NewWriter(calendarPath string) *Writer {
 	return &Writer{
 		basePath: calendarPath,
+		cache:    nil, // Cache is optional
+	}
+}
+
+// WithName sets the calendar name used in errors and cache keys. Without a
+// name the directory name is used.
+func (w *Writer) WithName(name string) *Writer {
+	w.name = name
+	return w
+}
+
+// WithReadOnly makes every write fail with a *ReadOnlyError
+func (w *Writer) WithReadOnly(readOnly bool) *Writer {
+	w.readOnly = readOnly
+	return w
+}
+
+// calendarName returns the configured name or the directory name
+func (w *Writer) calendarName() string {
+	if w.name != "" {
+		return w.name
+	}
+	return filepath.Base(w.basePath)
+}
+
+// checkWritable rejects writes to read-only calendars
+func (w *Writer) checkWritable() error {
+	if w.readOnly {
+		return &ReadOnlyError{Calendar: w.calendarName()}
+	}
+	return nil
+}
+
+// WithCache configures the writer to invalidate cached events it changes
+func (w *Writer) WithCache(c *cache.EventCache) *Writer {
+	w.cache = c
+	return w
+}
+
+// invalidate drops the cached copy of the event with uid
+func (w *Writer) invalidate(uid string) {
+	if w.cache != nil {
+		w.cache.Delete(w.calendarName(), uid)
+	}
+}
+
+// invalidateFile drops the cached events of the file at path, so a change
+// within the file system's timestamp granularity is not missed
+func (w *Writer) invalidateFile(path string) {
+	if w.cache == nil {
+		return
+	}
+	if rel, err := filepath.Rel(w.basePath, path); err == nil {
+		w.cache.DeleteFile(w.calendarName(), filepath.ToSlash(rel))
 	}
 }
 
 func (w *Writer) CreateEvent(event domain.Event) error {
+	if err := w.checkWritable(); err != nil {
+		return err
+	}
+	filename := filepath.Join(w.basePath, event.UID+".ics")
+	return w.writeObjects(filename, ical.Objects{Events: []domain.Event{event}})
+}
+
+// writeObjects atomically replaces filename with the given events and todos
+func (w *Writer) writeObjects(filename string, objects ical.Objects) error {
 	if err := os.MkdirAll(w.basePath, 0755); err != nil {
 		return err
 	}
 
-	filename := filepath.Join(w.basePath, event.UID+".ics")
 	tmpFile, err := os.CreateTemp(w.basePath, "tmp_*.ics")
 	if err != nil {
 		return fmt.Errorf("failed to create temporary file: %w", err)
@@ -37,8 +129,8 @@ func (w *Writer) CreateEvent(event domain.Event) error {
 		os.Remove(tmpFile.Name())
 	}()
 
-	if err := ical.GenerateEvent(event, tmpFile); err != nil {
-		return fmt.Errorf("failed to generate event: %w", err)
+	if err := ical.GenerateObjects(objects, tmpFile); err != nil {
+		return fmt.Errorf("failed to generate calendar: %w", err)
 	}
 
 	if err := tmpFile.Sync(); err != nil {
@@ -53,12 +145,49 @@ func (w *Writer) CreateEvent(event domain.Event) error {
 		return fmt.Errorf("failed to rename temporary file to %s: %w", filename, err)
 	}
 
+	w.invalidateFile(filename)
+	return nil
+}
+
+// replaceFile writes the remaining objects back to path, removing the file
+// when nothing is left in it
+func (w *Writer) replaceFile(path string, objects ical.Objects) error {
+	if !objects.IsEmpty() {
+		return w.writeObjects(path, objects)
+	}
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove %s: %w", path, err)
+	}
+	w.invalidateFile(path)
 	return nil
 }
 
 func (w *Writer) FindEventByUID(uid string) (domain.Event, error) {
-	var foundEvent domain.Event
-	var found bool
+	_, objects, err := w.findFile(uid, "event")
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	for _, event := range objects.Events {
+		if event.UID == uid {
+			return event, nil
+		}
+	}
+
+	return domain.Event{}, &NotFoundError{Kind: "event", UID: uid}
+}
+
+// EventPath returns the path of the .ics file holding the event with uid
+func (w *Writer) EventPath(uid string) (string, error) {
+	path, _, err := w.findFile(uid, "event")
+	return path, err
+}
+
+// findFile locates the .ics file containing the event or todo (kind) with
+// uid and returns its path together with everything stored in it
+func (w *Writer) findFile(uid, kind string) (string, ical.Objects, error) {
+	var foundPath string
+	var foundObjects ical.Objects
 
 	err := filepath.WalkDir(w.basePath, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
@@ -75,33 +204,179 @@ func (w *Writer) FindEventByUID(uid string) (domain.Event, error) {
 		}
 		defer file.Close()
 
-		events, err := ical.ParseEvents(file)
+		objects, err := ical.ParseObjects(file)
 		if err != nil {
-			return fmt.Errorf("failed to parse events from %s: %w", path, err)
+			return fmt.Errorf("failed to parse %s: %w", path, err)
 		}
 
-		for _, event := range events {
-			if event.UID == uid {
-				foundEvent = event
-				found = true
-				return fmt.Errorf("FOUND")
-			}
+		if containsUID(objects, uid, kind) {
+			foundPath = path
+			foundObjects = objects
+			return filepath.SkipAll
 		}
 
 		return nil
 	})
 
-	if found {
-		return foundEvent, nil
+	if foundPath != "" {
+		return foundPath, foundObjects, nil
 	}
 
-	if err != nil && err.Error() == "FOUND" {
-		return foundEvent, nil
+	if err != nil {
+		return "", ical.Objects{}, err
 	}
 
-	return domain.Event{}, fmt.Errorf("event with UID %s not found", uid)
+	return "", ical.Objects{}, &NotFoundError{Kind: kind, UID: uid}
+}
+
+// containsUID reports whether objects hold an event or todo (kind) with uid
+func containsUID(objects ical.Objects, uid, kind string) bool {
+	if kind == "todo" {
+		for _, todo := range objects.Todos {
+			if todo.UID == uid {
+				return true
+			}
+		}
+		return false
+	}
+	for _, event := range objects.Events {
+		if event.UID == uid {
+			return true
+		}
+	}
+	return false
 }
 
+// UpdateEvent rewrites the file holding the event in place, keeping its
+// overrides and any other events or todos stored in the same file. Events
+// that do not exist yet are created.
 func (w *Writer) UpdateEvent(event domain.Event) error {
-	return w.CreateEvent(event)
+	if err := w.checkWritable(); err != nil {
+		return err
+	}
+	path, objects, err := w.findFile(event.UID, "event")
+	if isNotFound(err) {
+		return w.CreateEvent(event)
+	}
+	if err != nil {
+		return err
+	}
+
+	for i := range objects.Events {
+		if objects.Events[i].UID == event.UID {
+			objects.Events[i] = event
+		}
+	}
+
+	if err := w.writeObjects(path, objects); err != nil {
+		return err
+	}
+
+	w.invalidate(event.UID)
+	return nil
+}
+
+// DeleteEvent removes the event with uid together with its overrides. The
+// file is removed when it holds nothing else and atomically rewritten
+// without the event otherwise.
+func (w *Writer) DeleteEvent(uid string) error {
+	if err := w.checkWritable(); err != nil {
+		return err
+	}
+	path, objects, err := w.findFile(uid, "event")
+	if err != nil {
+		return err
+	}
+
+	var remaining []domain.Event
+	for _, event := range objects.Events {
+		if event.UID != uid {
+			remaining = append(remaining, event)
+		}
+	}
+	objects.Events = remaining
+
+	if err := w.replaceFile(path, objects); err != nil {
+		return err
+	}
+
+	w.invalidate(uid)
+	return nil
+}
+
+func (w *Writer) CreateTodo(todo domain.Todo) error {
+	if err := w.checkWritable(); err != nil {
+		return err
+	}
+	filename := filepath.Join(w.basePath, todo.UID+".ics")
+	return w.writeObjects(filename, ical.Objects{Todos: []domain.Todo{todo}})
+}
+
+func (w *Writer) FindTodoByUID(uid string) (domain.Todo, error) {
+	_, objects, err := w.findFile(uid, "todo")
+	if err != nil {
+		return domain.Todo{}, err
+	}
+
+	for _, todo := range objects.Todos {
+		if todo.UID == uid {
+			todo.Calendar = w.calendarName()
+			return todo, nil
+		}
+	}
+
+	return domain.Todo{}, &NotFoundError{Kind: "todo", UID: uid}
+}
+
+// UpdateTodo rewrites the file holding the todo in place, keeping anything
+// else stored in it. Todos that do not exist yet are created.
+func (w *Writer) UpdateTodo(todo domain.Todo) error {
+	if err := w.checkWritable(); err != nil {
+		return err
+	}
+	path, objects, err := w.findFile(todo.UID, "todo")
+	if isNotFound(err) {
+		return w.CreateTodo(todo)
+	}
+	if err != nil {
+		return err
+	}
+
+	for i := range objects.Todos {
+		if objects.Todos[i].UID == todo.UID {
+			objects.Todos[i] = todo
+		}
+	}
+
+	return w.writeObjects(path, objects)
+}
+
+// DeleteTodo removes the todo with uid, and its file when nothing else is
+// stored in it
+func (w *Writer) DeleteTodo(uid string) error {
+	if err := w.checkWritable(); err != nil {
+		return err
+	}
+	path, objects, err := w.findFile(uid, "todo")
+	if err != nil {
+		return err
+	}
+
+	var remaining []domain.Todo
+	for _, todo := range objects.Todos {
+		if todo.UID != uid {
+			remaining = append(remaining, todo)
+		}
+	}
+	objects.Todos = remaining
+
+	return w.replaceFile(path, objects)
+}
+
+func (w *Writer) CreateJournal(journal domain.Journal) error {
+	if err := w.checkWritable(); err != nil {
+		return err
+	}
+	filename := filepath.Join(w.basePath, journal.UID+".ics")
+	return w.writeObjects(filename, ical.Objects{Journals: []domain.Journal{journal}})
 }
@@ -1,12 +1,14 @@
 package vdir
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/NaMinhyeok/calcli/internal/domain"
+	"github.com/NaMinhyeok/calcli/internal/storage/cache"
 )
 
 func TestWriter_CreateEvent(t *testing.T) {
@@ -78,3 +80,354 @@ func indexString(s, substr string) int {
 	}
 	return -1
 }
+
+func TestWriter_UpdateEventKeepsOverrides(t *testing.T) {
+	tmpDir := t.TempDir()
+	writer := NewWriter(tmpDir)
+
+	// A file synced from a server, named independently of the UID
+	path := filepath.Join(tmpDir, "3f2a9c.ics")
+	data := `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Test//Test//EN
+BEGIN:VEVENT
+UID:series-1
+SUMMARY:Planning
+DTSTART:20250901T100000Z
+DTEND:20250901T110000Z
+RRULE:FREQ=WEEKLY;COUNT=4
+ATTENDEE;PARTSTAT=ACCEPTED;CN=Bob:mailto:bob@example.com
+CATEGORIES:WORK,PLANNING
+X-GOOGLE-CONFERENCE:https://meet.example.com/abc
+END:VEVENT
+BEGIN:VEVENT
+UID:series-1
+RECURRENCE-ID:20250908T100000Z
+SUMMARY:Planning
+LOCATION:Room 2
+DTSTART:20250908T140000Z
+DTEND:20250908T150000Z
+END:VEVENT
+END:VCALENDAR
+`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+
+	event, err := writer.FindEventByUID("series-1")
+	if err != nil {
+		t.Fatalf("expected to find event, got %v", err)
+	}
+	if len(event.Overrides) != 1 {
+		t.Fatalf("expected 1 override, got %d", len(event.Overrides))
+	}
+
+	event.Summary = "Sprint Planning"
+	if err := writer.UpdateEvent(event); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(tmpDir, "series-1.ics")); !os.IsNotExist(err) {
+		t.Error("expected update to rewrite the original file instead of creating a new one")
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read updated file: %v", err)
+	}
+	expectedLines := []string{
+		"SUMMARY:Sprint Planning",
+		"RECURRENCE-ID:20250908T100000Z",
+		"LOCATION:Room 2",
+		"ATTENDEE;PARTSTAT=ACCEPTED;CN=Bob:mailto:bob@example.com",
+		"CATEGORIES:WORK,PLANNING",
+		"X-GOOGLE-CONFERENCE:https://meet.example.com/abc",
+	}
+	for _, expected := range expectedLines {
+		if !containsString(string(content), expected) {
+			t.Errorf("expected content to contain %q, got:\n%s", expected, content)
+		}
+	}
+}
+
+func TestWriter_DeleteEvent(t *testing.T) {
+	t.Run("removes the file of a single event", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		eventCache := cache.NewEventCache(0, true)
+		writer := NewWriter(tmpDir).WithCache(eventCache)
+
+		event := domain.Event{
+			UID:     "delete-me",
+			Summary: "Delete Me",
+			Start:   time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+			End:     time.Date(2025, 9, 1, 11, 0, 0, 0, time.UTC),
+		}
+		if err := writer.CreateEvent(event); err != nil {
+			t.Fatalf("failed to create event: %v", err)
+		}
+		eventCache.Set(filepath.Base(tmpDir), event.UID, event, time.Now())
+
+		if err := writer.DeleteEvent("delete-me"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if _, err := os.Stat(filepath.Join(tmpDir, "delete-me.ics")); !os.IsNotExist(err) {
+			t.Error("expected ICS file to be removed")
+		}
+		if _, ok := eventCache.Get(filepath.Base(tmpDir), "delete-me"); ok {
+			t.Error("expected cache entry to be invalidated")
+		}
+	})
+
+	t.Run("keeps other events in the same file", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		writer := NewWriter(tmpDir)
+
+		path := filepath.Join(tmpDir, "shared.ics")
+		data := `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Test//Test//EN
+BEGIN:VEVENT
+UID:keep
+SUMMARY:Keep
+DTSTART:20250901T100000Z
+DTEND:20250901T110000Z
+END:VEVENT
+BEGIN:VEVENT
+UID:drop
+SUMMARY:Drop
+DTSTART:20250902T100000Z
+DTEND:20250902T110000Z
+RRULE:FREQ=DAILY;COUNT=3
+END:VEVENT
+BEGIN:VEVENT
+UID:drop
+RECURRENCE-ID:20250903T100000Z
+SUMMARY:Drop (moved)
+DTSTART:20250903T120000Z
+DTEND:20250903T130000Z
+END:VEVENT
+END:VCALENDAR
+`
+		if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatalf("failed to write fixture: %v", err)
+		}
+
+		if err := writer.DeleteEvent("drop"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("failed to read rewritten file: %v", err)
+		}
+		if !containsString(string(content), "UID:keep") {
+			t.Errorf("expected remaining event to be kept, got:\n%s", content)
+		}
+		if containsString(string(content), "UID:drop") {
+			t.Errorf("expected deleted series and its override to be removed, got:\n%s", content)
+		}
+	})
+
+	t.Run("unknown UID", func(t *testing.T) {
+		writer := NewWriter(t.TempDir())
+		if err := writer.DeleteEvent("missing"); err == nil {
+			t.Error("expected error for unknown UID")
+		}
+	})
+}
+
+func TestWriter_ReadOnly(t *testing.T) {
+	tmpDir := t.TempDir()
+	event := domain.Event{
+		UID:     "holiday-1",
+		Summary: "New Year",
+		Start:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:     time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		AllDay:  true,
+	}
+	if err := NewWriter(tmpDir).CreateEvent(event); err != nil {
+		t.Fatalf("failed to create event: %v", err)
+	}
+	path := filepath.Join(tmpDir, "holiday-1.ics")
+	original, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read event file: %v", err)
+	}
+
+	writer := NewWriter(tmpDir).WithName("holidays").WithReadOnly(true)
+
+	if _, err := writer.FindEventByUID("holiday-1"); err != nil {
+		t.Errorf("expected reads to work, got %v", err)
+	}
+
+	changed := event
+	changed.Summary = "Changed"
+	writes := map[string]func() error{
+		"create": func() error {
+			return writer.CreateEvent(domain.Event{UID: "other", Summary: "Other", Start: event.Start})
+		},
+		"update": func() error { return writer.UpdateEvent(changed) },
+		"delete": func() error { return writer.DeleteEvent("holiday-1") },
+	}
+	for name, write := range writes {
+		var readOnlyErr *ReadOnlyError
+		if err := write(); !errors.As(err, &readOnlyErr) || readOnlyErr.Calendar != "holidays" {
+			t.Errorf("%s: expected ReadOnlyError for holidays, got %v", name, err)
+		}
+	}
+
+	current, err := os.ReadFile(path)
+	if err != nil || string(current) != string(original) {
+		t.Error("expected event file to be left untouched")
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "other.ics")); !os.IsNotExist(err) {
+		t.Error("expected no new file in a read-only calendar")
+	}
+}
+
+func TestWriter_Todos(t *testing.T) {
+	tmpDir := t.TempDir()
+	writer := NewWriter(tmpDir).WithName("tasks")
+
+	path := filepath.Join(tmpDir, "mixed.ics")
+	data := `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Test//Test//EN
+BEGIN:VEVENT
+UID:event-1
+SUMMARY:Review
+DTSTART:20250901T100000Z
+DTEND:20250901T110000Z
+END:VEVENT
+BEGIN:VTODO
+UID:todo-1
+SUMMARY:Prepare slides
+DUE:20250901T090000Z
+X-APPLE-SORT-ORDER:1
+END:VTODO
+END:VCALENDAR
+`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+
+	todo, err := writer.FindTodoByUID("todo-1")
+	if err != nil {
+		t.Fatalf("expected to find todo, got %v", err)
+	}
+	if todo.Summary != "Prepare slides" || todo.Calendar != "tasks" {
+		t.Errorf("unexpected todo %+v", todo)
+	}
+
+	todo.Status = domain.TodoInProcess
+	if err := writer.UpdateTodo(todo); err != nil {
+		t.Fatalf("failed to update todo: %v", err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read rewritten file: %v", err)
+	}
+	for _, expected := range []string{"UID:event-1", "STATUS:IN-PROCESS", "X-APPLE-SORT-ORDER:1"} {
+		if !containsString(string(content), expected) {
+			t.Errorf("expected %q after update, got:\n%s", expected, content)
+		}
+	}
+
+	// Updating the event must keep the todo stored next to it
+	event, err := writer.FindEventByUID("event-1")
+	if err != nil {
+		t.Fatalf("expected to find event, got %v", err)
+	}
+	event.Summary = "Review (moved)"
+	if err := writer.UpdateEvent(event); err != nil {
+		t.Fatalf("failed to update event: %v", err)
+	}
+	if content, _ := os.ReadFile(path); !containsString(string(content), "UID:todo-1") {
+		t.Errorf("expected todo to survive an event update, got:\n%s", content)
+	}
+
+	if err := writer.DeleteTodo("todo-1"); err != nil {
+		t.Fatalf("failed to delete todo: %v", err)
+	}
+	if _, err := writer.FindTodoByUID("todo-1"); err == nil {
+		t.Error("expected deleted todo to be gone")
+	}
+	if _, err := writer.FindEventByUID("event-1"); err != nil {
+		t.Errorf("expected event to be kept, got %v", err)
+	}
+
+	if err := writer.DeleteEvent("event-1"); err != nil {
+		t.Fatalf("failed to delete event: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("expected empty file to be removed")
+	}
+
+	due := time.Date(2025, 9, 5, 0, 0, 0, 0, time.UTC)
+	if err := writer.CreateTodo(domain.Todo{UID: "todo-2", Summary: "Call bank", Due: &due, AllDay: true}); err != nil {
+		t.Fatalf("failed to create todo: %v", err)
+	}
+	content, err = os.ReadFile(filepath.Join(tmpDir, "todo-2.ics"))
+	if err != nil {
+		t.Fatalf("expected todo file, got %v", err)
+	}
+	if !containsString(string(content), "DUE;VALUE=DATE:20250905") {
+		t.Errorf("expected all-day due date, got:\n%s", content)
+	}
+}
+
+func TestWriter_UpdateCreatesOnlyMissing(t *testing.T) {
+	event := domain.Event{
+		UID:     "meeting-1",
+		Summary: "Review",
+		Start:   time.Date(2025, 9, 3, 10, 0, 0, 0, time.UTC),
+		End:     time.Date(2025, 9, 3, 11, 0, 0, 0, time.UTC),
+	}
+	todo := domain.Todo{UID: "task-1", Summary: "Write report"}
+
+	t.Run("missing items are created", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		writer := NewWriter(tmpDir)
+
+		if err := writer.UpdateEvent(event); err != nil {
+			t.Fatalf("failed to update event: %v", err)
+		}
+		if err := writer.UpdateTodo(todo); err != nil {
+			t.Fatalf("failed to update todo: %v", err)
+		}
+		for _, name := range []string{"meeting-1.ics", "task-1.ics"} {
+			if _, err := os.Stat(filepath.Join(tmpDir, name)); err != nil {
+				t.Errorf("expected %s to be created: %v", name, err)
+			}
+		}
+	})
+
+	t.Run("lookup errors are returned", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		if err := os.Symlink(filepath.Join(tmpDir, "missing"), filepath.Join(tmpDir, "broken.ics")); err != nil {
+			t.Fatalf("failed to create symlink: %v", err)
+		}
+		writer := NewWriter(tmpDir)
+
+		var notFound *NotFoundError
+		if err := writer.UpdateEvent(event); err == nil || errors.As(err, &notFound) {
+			t.Errorf("expected the lookup error for the event, got %v", err)
+		}
+		if err := writer.UpdateTodo(todo); err == nil || errors.As(err, &notFound) {
+			t.Errorf("expected the lookup error for the todo, got %v", err)
+		}
+		for _, name := range []string{"meeting-1.ics", "task-1.ics"} {
+			if _, err := os.Stat(filepath.Join(tmpDir, name)); !os.IsNotExist(err) {
+				t.Errorf("expected no %s after a failed lookup", name)
+			}
+		}
+	})
+
+	t.Run("not found is typed", func(t *testing.T) {
+		var notFound *NotFoundError
+		if _, err := NewWriter(t.TempDir()).FindEventByUID("meeting-1"); !errors.As(err, &notFound) || notFound.UID != "meeting-1" {
+			t.Errorf("expected NotFoundError for meeting-1, got %v", err)
+		}
+	})
+}
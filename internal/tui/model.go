@@ -14,6 +14,7 @@ import (
 type Model struct {
 	// Data
 	events       []domain.Event
+	journals     []domain.Journal
 	currentMonth time.Time
 
 	// UI State
@@ -24,29 +25,132 @@ type Model struct {
 
 	// View mode
 	showHelp bool
+	view     viewMode
+
+	// Working hours of the day view, render defaults when both are zero
+	firstHour int
+	lastHour  int
+
+	// Event awaiting delete confirmation, and the last action's outcome
+	pendingDelete *domain.Event
+	status        string
+
+	// Zone in which days and times are displayed
+	location *time.Location
 
 	// Reader for loading events
 	reader EventReader
+
+	// Reader for loading journal entries, nil when they are not shown
+	journalReader JournalReader
+
+	// Deleter for removing events, nil when deletion is unavailable
+	deleter EventDeleter
+
+	// Calendars whose events cannot be modified
+	readOnly map[string]bool
+
+	// Colors of calendars by name
+	colors map[string]string
+
+	// Layout and names of weeks
+	weekStart       time.Weekday
+	locale          render.Locale
+	showWeekNumbers bool
 }
 
+// viewMode is the span of time shown above the event list
+type viewMode int
+
+const (
+	monthView viewMode = iota
+	weekView
+	dayView // the selected day
+)
+
 // EventReader interface for loading events
 type EventReader interface {
 	ListEvents() ([]domain.Event, error)
 }
 
-// NewModel creates a new TUI model
-func NewModel(reader EventReader) Model {
+// JournalReader interface for loading journal entries
+type JournalReader interface {
+	ListJournals() ([]domain.Journal, error)
+}
+
+// EventDeleter interface for deleting events and single occurrences
+type EventDeleter interface {
+	FindEventByUID(uid string) (domain.Event, error)
+	UpdateEvent(event domain.Event) error
+	DeleteEvent(uid string) error
+}
+
+// NewModel creates a new TUI model displaying times in loc
+func NewModel(reader EventReader, loc *time.Location) Model {
+	if loc == nil {
+		loc = time.Local
+	}
+	now := time.Now().In(loc)
 	return Model{
-		currentMonth:  time.Now(),
-		selectedDay:   time.Now().Day(),
+		currentMonth:  now,
+		selectedDay:   now.Day(),
 		selectedEvent: 0,
 		showHelp:      false,
+		location:      loc,
 		reader:        reader,
+		locale:        render.English,
 	}
 }
 
+// WithDeleter enables deleting events with the "d" key
+func (m Model) WithDeleter(deleter EventDeleter) Model {
+	m.deleter = deleter
+	return m
+}
+
+// WithJournals marks days with journal entries and lists the entries of
+// the selected day
+func (m Model) WithJournals(reader JournalReader) Model {
+	m.journalReader = reader
+	return m
+}
+
+// WithWorkingHours sets the hours the day view shows and finds free time in
+func (m Model) WithWorkingHours(first, last int) Model {
+	m.firstHour, m.lastHour = first, last
+	return m
+}
+
+// WithColors shows events in the colors of their calendars
+func (m Model) WithColors(colors map[string]string) Model {
+	m.colors = colors
+	return m
+}
+
+// WithWeekLayout sets the first day of weeks, the locale naming months and
+// weekdays, and whether the month view shows week numbers
+func (m Model) WithWeekLayout(weekStart time.Weekday, locale render.Locale, showWeekNumbers bool) Model {
+	if locale.MonthYear == "" {
+		locale = render.English
+	}
+	m.weekStart, m.locale, m.showWeekNumbers = weekStart, locale, showWeekNumbers
+	return m
+}
+
+// WithReadOnly marks calendars whose events must not be deleted
+func (m Model) WithReadOnly(calendars ...string) Model {
+	m.readOnly = make(map[string]bool, len(calendars))
+	for _, name := range calendars {
+		m.readOnly[name] = true
+	}
+	return m
+}
+
 // Init initializes the model
 func (m Model) Init() tea.Cmd {
+	if m.journalReader != nil {
+		return tea.Batch(loadEvents(m.reader), loadJournals(m.journalReader))
+	}
 	return loadEvents(m.reader)
 }
 
@@ -63,6 +167,21 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case eventsLoadedMsg:
 		m.events = msg.events
+		if events := m.getEventsForSelectedDay(); m.selectedEvent >= len(events) {
+			m.selectedEvent = max(len(events)-1, 0)
+		}
+		return m, nil
+
+	case journalsLoadedMsg:
+		m.journals = msg.journals
+		return m, nil
+
+	case eventDeletedMsg:
+		m.status = msg.status
+		return m, loadEvents(m.reader)
+
+	case statusMsg:
+		m.status = msg.status
 		return m, nil
 
 	case errMsg:
@@ -74,6 +193,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.pendingDelete != nil {
+		return m.handleDeleteConfirmation(msg)
+	}
+	m.status = ""
+
 	switch msg.String() {
 	case "q", "ctrl+c", "esc":
 		return m, tea.Quit
@@ -82,21 +206,45 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.showHelp = !m.showHelp
 		return m, nil
 
+	case "w":
+		if !m.showHelp {
+			m.view = m.toggleView(weekView)
+		}
+		return m, nil
+
+	case "z":
+		if !m.showHelp {
+			m.view = m.toggleView(dayView)
+		}
+		return m, nil
+
 	case "left", "h":
 		if m.showHelp {
 			return m, nil
 		}
+		switch m.view {
+		case weekView:
+			return m.moveSelectedDay(-7), loadEvents(m.reader)
+		case dayView:
+			return m.moveSelectedDay(-1), loadEvents(m.reader)
+		}
 		m.currentMonth = m.currentMonth.AddDate(0, -1, 0)
 		m.selectedDay = 1
 		return m, loadEvents(m.reader)
 
 	case "right", "l":
-		if !m.showHelp {
-			m.currentMonth = m.currentMonth.AddDate(0, 1, 0)
-			m.selectedDay = 1
-			return m, loadEvents(m.reader)
+		if m.showHelp {
+			return m, nil
 		}
-		return m, nil
+		switch m.view {
+		case weekView:
+			return m.moveSelectedDay(7), loadEvents(m.reader)
+		case dayView:
+			return m.moveSelectedDay(1), loadEvents(m.reader)
+		}
+		m.currentMonth = m.currentMonth.AddDate(0, 1, 0)
+		m.selectedDay = 1
+		return m, loadEvents(m.reader)
 
 	case "up", "k":
 		if m.selectedEvent > 0 {
@@ -120,7 +268,19 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case "d":
-		// TODO: Delete event (future implementation)
+		if m.deleter == nil {
+			m.status = "Deleting is not available"
+			return m, nil
+		}
+		events := m.getEventsForSelectedDay()
+		if m.selectedEvent < len(events) {
+			event := events[m.selectedEvent]
+			if m.readOnly[event.Calendar] {
+				m.status = fmt.Sprintf("Calendar '%s' is read-only", event.Calendar)
+				return m, nil
+			}
+			m.pendingDelete = &event
+		}
 		return m, nil
 
 	case "e":
@@ -131,6 +291,54 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// toggleView returns view, or the month view when view is already shown
+func (m Model) toggleView(view viewMode) viewMode {
+	if m.view == view {
+		return monthView
+	}
+	return view
+}
+
+// moveSelectedDay selects the day days away from the selected one, moving
+// to its month
+func (m Model) moveSelectedDay(days int) Model {
+	day := max(m.selectedDay, 1)
+	selected := time.Date(m.currentMonth.Year(), m.currentMonth.Month(), day, 0, 0, 0, 0, m.location).AddDate(0, 0, days)
+	m.currentMonth = selected
+	m.selectedDay = selected.Day()
+	m.selectedEvent = 0
+	return m
+}
+
+// handleDeleteConfirmation answers the prompt shown after "d": recurring
+// events offer deleting one occurrence or the whole series
+func (m Model) handleDeleteConfirmation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	event := *m.pendingDelete
+	m.pendingDelete = nil
+
+	switch key := msg.String(); {
+	case m.isRecurring(event.UID) && key == "o":
+		return m, deleteOccurrence(m.deleter, event.UID, event.OriginalStart())
+	case m.isRecurring(event.UID) && key == "a":
+		return m, deleteEvent(m.deleter, event)
+	case !m.isRecurring(event.UID) && key == "y":
+		return m, deleteEvent(m.deleter, event)
+	}
+
+	m.status = "Cancelled"
+	return m, nil
+}
+
+// isRecurring reports whether the loaded event with uid is a series
+func (m Model) isRecurring(uid string) bool {
+	for _, event := range m.events {
+		if event.UID == uid && event.IsRecurring() {
+			return true
+		}
+	}
+	return false
+}
+
 // View renders the UI
 func (m Model) View() string {
 	if m.showHelp {
@@ -139,17 +347,31 @@ func (m Model) View() string {
 
 	var b strings.Builder
 
-	// Title
-	b.WriteString(fmt.Sprintf("\n  Calendar - %s %d\n\n", m.currentMonth.Month(), m.currentMonth.Year()))
-
-	// Calendar view
-	b.WriteString(m.renderCalendar())
+	// Title and calendar view
+	switch m.view {
+	case weekView:
+		first := m.weekView().Days()[0]
+		b.WriteString(fmt.Sprintf("\n  Week of %s %s\n\n", m.locale.Weekday(first.Weekday()), first.Format("2006-01-02")))
+		b.WriteString(m.renderWeek())
+	case dayView:
+		b.WriteString("\n")
+		b.WriteString(m.renderDay())
+	default:
+		b.WriteString(fmt.Sprintf("\n  Calendar - %s\n\n", m.locale.FormatMonthYear(m.currentMonth.Month(), m.currentMonth.Year())))
+		b.WriteString(m.renderCalendar())
+	}
 	b.WriteString("\n")
 
 	// Event list
 	b.WriteString(m.renderEventList())
 	b.WriteString("\n")
 
+	// Prompt or status line
+	if line := m.renderStatus(); line != "" {
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
 	// Footer
 	b.WriteString(m.renderFooter())
 
@@ -158,33 +380,79 @@ func (m Model) View() string {
 
 func (m Model) renderCalendar() string {
 	// Get events for current month
-	firstDay := time.Date(m.currentMonth.Year(), m.currentMonth.Month(), 1, 0, 0, 0, 0, time.UTC)
+	firstDay := time.Date(m.currentMonth.Year(), m.currentMonth.Month(), 1, 0, 0, 0, 0, m.location)
 	lastDay := firstDay.AddDate(0, 1, 0).Add(-time.Second)
 
-	// Filter events for this month
-	var monthEvents []domain.Event
-	for _, event := range m.events {
-		if !event.Start.Before(firstDay) && !event.Start.After(lastDay) {
-			monthEvents = append(monthEvents, event)
-		}
-	}
-
-	// Expand recurring events
-	var expandedEvents []domain.Event
-	for _, event := range monthEvents {
-		instances := domain.ExpandRecurrence(event, firstDay, lastDay)
-		expandedEvents = append(expandedEvents, instances...)
-	}
-
 	// Use render.MonthView
-	view := render.NewMonthView(m.currentMonth, expandedEvents)
+	view := render.NewMonthView(firstDay, m.eventsBetween(firstDay, lastDay))
+	view.Journals = m.journals
+	view.Colors = m.colors
 	view.Today = time.Now()
+	view.WeekStart = m.weekStart
+	view.Locale = m.locale
+	view.ShowWeekNumbers = m.showWeekNumbers
+
+	var buf strings.Builder
+	view.Render(&buf)
+	return buf.String()
+}
+
+// renderWeek renders the week of the selected day as a time grid fitting
+// the terminal
+func (m Model) renderWeek() string {
+	view := m.weekView()
+	view.Width = m.width
+	view.Colors = m.colors
+	days := view.Days()
+	view.Events = m.eventsBetween(days[0], days[len(days)-1].AddDate(0, 0, 1).Add(-time.Second))
 
 	var buf strings.Builder
 	view.Render(&buf)
 	return buf.String()
 }
 
+// weekView returns a view of the week holding the selected day, without
+// events
+func (m Model) weekView() *render.WeekView {
+	view := render.NewWeekView(m.selectedDate(), nil)
+	view.Location = m.location
+	view.WeekStart = m.weekStart
+	view.Locale = m.locale
+	return view
+}
+
+// renderDay renders the selected day as a timeline fitting the terminal
+func (m Model) renderDay() string {
+	day := m.selectedDate()
+	view := render.NewDayView(day, m.eventsBetween(day, day.AddDate(0, 0, 1).Add(-time.Second)))
+	view.Location = m.location
+	view.Width = m.width
+	view.FirstHour = m.firstHour
+	view.LastHour = m.lastHour
+	view.Colors = m.colors
+	view.Locale = m.locale
+
+	var buf strings.Builder
+	view.Render(&buf)
+	return buf.String()
+}
+
+// selectedDate returns midnight of the selected day, the first of the month
+// when no day is selected
+func (m Model) selectedDate() time.Time {
+	return time.Date(m.currentMonth.Year(), m.currentMonth.Month(), max(m.selectedDay, 1), 0, 0, 0, 0, m.location)
+}
+
+// eventsBetween expands recurring events and returns the instances covering
+// any part of [from, to], converted to the display zone
+func (m Model) eventsBetween(from, to time.Time) []domain.Event {
+	events := domain.OccurrencesBetween(m.events, from, to)
+	for i := range events {
+		events[i] = events[i].In(m.location)
+	}
+	return events
+}
+
 func (m Model) renderEventList() string {
 	events := m.getEventsForSelectedDay()
 
@@ -193,13 +461,14 @@ func (m Model) renderEventList() string {
 	b.WriteString(strings.Repeat("-", 50))
 	b.WriteString("\n")
 
-	if len(events) == 0 {
+	journals := m.getJournalsForSelectedDay()
+	if len(events) == 0 && len(journals) == 0 {
 		b.WriteString("No events for this day.\n")
 		return b.String()
 	}
 
-	selectedDate := time.Date(m.currentMonth.Year(), m.currentMonth.Month(), m.selectedDay, 0, 0, 0, 0, time.UTC)
-	b.WriteString(fmt.Sprintf("\n%s %s:\n", selectedDate.Weekday().String()[:3], selectedDate.Format("2006-01-02")))
+	selectedDate := time.Date(m.currentMonth.Year(), m.currentMonth.Month(), m.selectedDay, 0, 0, 0, 0, m.location)
+	b.WriteString(fmt.Sprintf("\n%s %s:\n", m.locale.Weekday(selectedDate.Weekday()), selectedDate.Format("2006-01-02")))
 
 	for i, event := range events {
 		prefix := "  "
@@ -207,15 +476,57 @@ func (m Model) renderEventList() string {
 			prefix = "> "
 		}
 
-		startTime := event.Start.Format("15:04")
-		b.WriteString(fmt.Sprintf("%s%s %s\n", prefix, startTime, event.Summary))
+		b.WriteString(fmt.Sprintf("%s%s\n", prefix, render.Colorize(m.colors[event.Calendar], render.FormatDayEntry(event, selectedDate))))
+		if i == m.selectedEvent {
+			b.WriteString(renderEventDetails(event))
+		}
+	}
+
+	for _, journal := range journals {
+		b.WriteString(fmt.Sprintf("  %s\n", render.FormatJournalEntry(journal)))
 	}
 
 	return b.String()
 }
 
+// renderEventDetails lists the location and participants of the selected
+// event below it
+func renderEventDetails(event domain.Event) string {
+	var b strings.Builder
+	if event.Location != "" {
+		b.WriteString(fmt.Sprintf("      @ %s\n", event.Location))
+	}
+	if event.Organizer != nil {
+		b.WriteString(fmt.Sprintf("      Organizer: %s\n", event.Organizer))
+	}
+	for _, attendee := range event.Attendees {
+		b.WriteString(fmt.Sprintf("      - %s\n", render.FormatAttendee(attendee)))
+	}
+	return b.String()
+}
+
+func (m Model) renderStatus() string {
+	if m.pendingDelete == nil {
+		if m.status == "" {
+			return ""
+		}
+		return "  " + m.status + "\n"
+	}
+
+	if m.isRecurring(m.pendingDelete.UID) {
+		return fmt.Sprintf("  Delete '%s': (o) this occurrence, (a) all occurrences, any other key cancels\n", m.pendingDelete.Summary)
+	}
+	return fmt.Sprintf("  Delete '%s'? (y/n)\n", m.pendingDelete.Summary)
+}
+
 func (m Model) renderFooter() string {
-	return "  ←/→: month  ↑/↓: event  q: quit  ?: help\n"
+	switch m.view {
+	case weekView:
+		return "  ←/→: week  ↑/↓: event  w: month view  z: day view  d: delete  q: quit  ?: help\n"
+	case dayView:
+		return "  ←/→: day  ↑/↓: event  z: month view  w: week view  d: delete  q: quit  ?: help\n"
+	}
+	return "  ←/→: month  ↑/↓: event  w: week view  z: day view  d: delete  q: quit  ?: help\n"
 }
 
 func (m Model) renderHelp() string {
@@ -223,15 +534,23 @@ func (m Model) renderHelp() string {
 
 	b.WriteString("\n  Calendar Help\n\n")
 	b.WriteString("  Navigation:\n")
-	b.WriteString("    ← / → : Previous/Next month\n")
+	b.WriteString("    ← / → : Previous/Next month (week or day when zoomed in)\n")
 	b.WriteString("    ↑ / ↓ : Previous/Next event\n")
 	b.WriteString("    h / l : Same as ← / →\n")
 	b.WriteString("    j / k : Same as ↑ / ↓\n")
 	b.WriteString("\n")
+	b.WriteString("    w     : Toggle week view\n")
+	b.WriteString("    z     : Toggle day view of the selected day\n")
+	b.WriteString("\n")
 	b.WriteString("  Actions:\n")
 	b.WriteString("    n     : New event (coming soon)\n")
 	b.WriteString("    e     : Edit event (coming soon)\n")
-	b.WriteString("    d     : Delete event (coming soon)\n")
+	b.WriteString("    d     : Delete event or occurrence\n")
+	b.WriteString("\n")
+	b.WriteString("  Markers:\n")
+	b.WriteString("    *     : Today\n")
+	b.WriteString("    •     : Day with events\n")
+	b.WriteString("    " + render.JournalMarker + "     : Day with journal entries, before the day\n")
 	b.WriteString("\n")
 	b.WriteString("  Other:\n")
 	b.WriteString("    ?     : Toggle this help\n")
@@ -247,19 +566,27 @@ func (m Model) getEventsForSelectedDay() []domain.Event {
 		return nil
 	}
 
-	selectedDate := time.Date(m.currentMonth.Year(), m.currentMonth.Month(), m.selectedDay, 0, 0, 0, 0, time.UTC)
+	dayStart := time.Date(m.currentMonth.Year(), m.currentMonth.Month(), m.selectedDay, 0, 0, 0, 0, m.location)
+	dayEnd := dayStart.AddDate(0, 0, 1).Add(-time.Second)
 
-	var dayEvents []domain.Event
-	for _, event := range m.events {
-		eventDate := event.Start.Format("2006-01-02")
-		selectedDateStr := selectedDate.Format("2006-01-02")
+	return m.eventsBetween(dayStart, dayEnd)
+}
 
-		if eventDate == selectedDateStr {
-			dayEvents = append(dayEvents, event)
-		}
+// getJournalsForSelectedDay returns the journal entries of the selected day
+func (m Model) getJournalsForSelectedDay() []domain.Journal {
+	if m.selectedDay == 0 {
+		return nil
 	}
 
-	return dayEvents
+	day := time.Date(m.currentMonth.Year(), m.currentMonth.Month(), m.selectedDay, 0, 0, 0, 0, m.location)
+	var journals []domain.Journal
+	for _, journal := range m.journals {
+		if journal.OnDate(day, m.location) {
+			journals = append(journals, journal)
+		}
+	}
+	domain.SortJournals(journals)
+	return journals
 }
 
 // Messages
@@ -268,10 +595,22 @@ type eventsLoadedMsg struct {
 	events []domain.Event
 }
 
+type journalsLoadedMsg struct {
+	journals []domain.Journal
+}
+
 type errMsg struct {
 	err error
 }
 
+type eventDeletedMsg struct {
+	status string
+}
+
+type statusMsg struct {
+	status string
+}
+
 // Commands
 
 func loadEvents(reader EventReader) tea.Cmd {
@@ -283,3 +622,35 @@ func loadEvents(reader EventReader) tea.Cmd {
 		return eventsLoadedMsg{events}
 	}
 }
+
+func loadJournals(reader JournalReader) tea.Cmd {
+	return func() tea.Msg {
+		journals, err := reader.ListJournals()
+		if err != nil {
+			return errMsg{err}
+		}
+		return journalsLoadedMsg{journals}
+	}
+}
+
+func deleteEvent(deleter EventDeleter, event domain.Event) tea.Cmd {
+	return func() tea.Msg {
+		if err := deleter.DeleteEvent(event.UID); err != nil {
+			return statusMsg{fmt.Sprintf("Failed to delete '%s': %v", event.Summary, err)}
+		}
+		return eventDeletedMsg{fmt.Sprintf("Deleted '%s'", event.Summary)}
+	}
+}
+
+func deleteOccurrence(deleter EventDeleter, uid string, occurrence time.Time) tea.Cmd {
+	return func() tea.Msg {
+		series, err := deleter.FindEventByUID(uid)
+		if err != nil {
+			return statusMsg{fmt.Sprintf("Failed to delete occurrence: %v", err)}
+		}
+		if err := deleter.UpdateEvent(series.ExcludeOccurrence(occurrence)); err != nil {
+			return statusMsg{fmt.Sprintf("Failed to delete occurrence of '%s': %v", series.Summary, err)}
+		}
+		return eventDeletedMsg{fmt.Sprintf("Deleted one occurrence of '%s'", series.Summary)}
+	}
+}
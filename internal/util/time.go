@@ -8,8 +8,20 @@ import (
 )
 
 func ParseDate(date string) (time.Time, error) {
-	now := time.Now()
-	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	return ParseDateIn(date, time.Local)
+}
+
+// ParseDateIn parses a date like ParseDate, returning midnight in loc
+func ParseDateIn(date string, loc *time.Location) (time.Time, error) {
+	return ParseDateInWeek(date, loc, time.Sunday)
+}
+
+// ParseDateInWeek parses a date like ParseDateIn, with "this-week",
+// "next-week" and "last-week" naming the first day of weeks starting on
+// weekStart
+func ParseDateInWeek(date string, loc *time.Location, weekStart time.Weekday) (time.Time, error) {
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
 
 	switch date {
 	case "today":
@@ -18,6 +30,12 @@ func ParseDate(date string) (time.Time, error) {
 		return today.AddDate(0, 0, 1), nil
 	case "yesterday":
 		return today.AddDate(0, 0, -1), nil
+	case "this-week":
+		return StartOfWeek(today, weekStart), nil
+	case "next-week":
+		return StartOfWeek(today, weekStart).AddDate(0, 0, 7), nil
+	case "last-week":
+		return StartOfWeek(today, weekStart).AddDate(0, 0, -7), nil
 	}
 
 	if matched, days := parseRelativeDays(date); matched {
@@ -28,7 +46,14 @@ func ParseDate(date string) (time.Time, error) {
 		return today.AddDate(0, 0, weeks*7), nil
 	}
 
-	return time.Parse("2006-01-02", date)
+	return time.ParseInLocation("2006-01-02", date, loc)
+}
+
+// StartOfWeek returns midnight of the first day of the week holding t, in
+// weeks starting on weekStart
+func StartOfWeek(t time.Time, weekStart time.Weekday) time.Time {
+	offset := (int(t.Weekday()) - int(weekStart) + 7) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
 }
 
 func parseRelativeDays(date string) (bool, int) {
@@ -69,6 +94,7 @@ func parseRelativeWeeks(date string) (bool, int) {
 	return true, weeks
 }
 
+// ParseTime parses a start time in the location of the time provider's clock
 func ParseTime(when string, timeProvider TimeProvider) (time.Time, error) {
 	formats := []string{
 		"2006-01-02 15:04",
@@ -76,12 +102,14 @@ func ParseTime(when string, timeProvider TimeProvider) (time.Time, error) {
 		"15:04", // today at time
 	}
 
+	now := timeProvider.Now()
+	loc := now.Location()
+
 	for _, format := range formats {
-		if t, err := time.Parse(format, when); err == nil {
+		if t, err := time.ParseInLocation(format, when, loc); err == nil {
 			if format == "15:04" {
-				now := timeProvider.Now()
 				return time.Date(now.Year(), now.Month(), now.Day(),
-					t.Hour(), t.Minute(), 0, 0, time.Local), nil
+					t.Hour(), t.Minute(), 0, 0, loc), nil
 			}
 			return t, nil
 		}
@@ -94,9 +122,15 @@ type TimeProvider interface {
 	Now() time.Time
 }
 
-type RealTimeProvider struct{}
+// RealTimeProvider returns the wall clock in Location, or time.Local when nil
+type RealTimeProvider struct {
+	Location *time.Location
+}
 
 func (t *RealTimeProvider) Now() time.Time {
+	if t.Location != nil {
+		return time.Now().In(t.Location)
+	}
 	return time.Now()
 }
 
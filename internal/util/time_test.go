@@ -63,7 +63,7 @@ func TestParseDate(t *testing.T) {
 		{
 			name:     "YYYY-MM-DD format",
 			input:    "2025-08-30",
-			expected: time.Date(2025, 8, 30, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 8, 30, 0, 0, 0, 0, time.Local),
 		},
 		{
 			name:     "invalid format",
@@ -110,6 +110,78 @@ func TestParseDate(t *testing.T) {
 	}
 }
 
+func TestParseDateIn(t *testing.T) {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	result, err := ParseDateIn("2025-08-30", seoul)
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+
+	expected := time.Date(2025, 8, 30, 0, 0, 0, 0, seoul)
+	if !result.Equal(expected) || result.Location() != seoul {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestStartOfWeek(t *testing.T) {
+	thursday := time.Date(2025, 9, 18, 15, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		weekStart time.Weekday
+		expected  time.Time
+	}{
+		{"sunday start", time.Sunday, time.Date(2025, 9, 14, 0, 0, 0, 0, time.UTC)},
+		{"monday start", time.Monday, time.Date(2025, 9, 15, 0, 0, 0, 0, time.UTC)},
+		{"saturday start", time.Saturday, time.Date(2025, 9, 13, 0, 0, 0, 0, time.UTC)},
+		{"same day", time.Thursday, time.Date(2025, 9, 18, 0, 0, 0, 0, time.UTC)},
+		{"next day", time.Friday, time.Date(2025, 9, 12, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := StartOfWeek(thursday, tt.weekStart)
+			if !result.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseDateInWeek(t *testing.T) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	thisWeek := StartOfWeek(today, time.Monday)
+
+	tests := []struct {
+		date     string
+		expected time.Time
+	}{
+		{"this-week", thisWeek},
+		{"next-week", thisWeek.AddDate(0, 0, 7)},
+		{"last-week", thisWeek.AddDate(0, 0, -7)},
+		{"2025-09-18", time.Date(2025, 9, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			result, err := ParseDateInWeek(tt.date, time.Local, time.Monday)
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+			if tt.date != "2025-09-18" && result.Weekday() != time.Monday {
+				t.Errorf("expected a Monday, got %v", result.Weekday())
+			}
+		})
+	}
+}
+
 // StubTimeProvider for testing
 type StubTimeProvider struct {
 	FixedTime time.Time
@@ -132,12 +204,12 @@ func TestParseTime(t *testing.T) {
 		{
 			name:     "full date and time",
 			input:    "2025-08-30 14:00",
-			expected: time.Date(2025, 8, 30, 14, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 8, 30, 14, 0, 0, 0, time.Local),
 		},
 		{
 			name:     "ISO format",
 			input:    "2025-08-30T14:00",
-			expected: time.Date(2025, 8, 30, 14, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 8, 30, 14, 0, 0, 0, time.Local),
 		},
 		{
 			name:     "time only uses current date",
@@ -174,6 +246,26 @@ func TestParseTime(t *testing.T) {
 	}
 }
 
+func TestParseTime_UsesProviderLocation(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	timeProvider := &StubTimeProvider{FixedTime: time.Date(2025, 8, 29, 10, 30, 0, 0, newYork)}
+
+	for _, input := range []string{"2025-08-29 15:30", "15:30"} {
+		result, err := ParseTime(input, timeProvider)
+		if err != nil {
+			t.Fatalf("expected no error but got: %v", err)
+		}
+
+		expected := time.Date(2025, 8, 29, 15, 30, 0, 0, newYork)
+		if !result.Equal(expected) {
+			t.Errorf("%s: expected %v, got %v", input, expected, result)
+		}
+	}
+}
+
 func TestParseDuration(t *testing.T) {
 	tests := []struct {
 		name     string